@@ -3,7 +3,9 @@ package api
 import (
 	"io/ioutil"
 	"net/http"
+	"time"
 
+	"github.com/ameteiko/golang-kit/cfg"
 	"github.com/ameteiko/golang-kit/errors"
 )
 
@@ -19,8 +21,11 @@ type HTTPClient interface {
 
 //
 // HTTP performs HTTP calls for external API-services.
+// The upstream client reads the upstream timeout and authorization on every call, so the reloaded values apply.
 //
-type HTTP struct{}
+type HTTP struct {
+	upstream cfg.UpstreamInfoProvider
+}
 
 //
 // NewHTTPClient creates a new HTTP client object.
@@ -46,8 +51,16 @@ func (c HTTP) RawCall(httpResource HTTPResourceInfoProvider) ([]byte, error) {
 			httpRequest.Header.Add(h, hValue)
 		}
 	}
+	var timeout time.Duration
+	if nil != c.upstream {
+		timeout = c.upstream.GetTimeout()
+		authorization := c.upstream.GetAuthorization()
+		if "" != authorization && "" == httpRequest.Header.Get("Authorization") {
+			httpRequest.Header.Set("Authorization", authorization)
+		}
+	}
 
-	httpClient := http.Client{}
+	httpClient := http.Client{Timeout: timeout}
 	httpResponse, err := httpClient.Do(httpRequest)
 	if nil != err {
 		return emptyResponse, errors.WrapError(
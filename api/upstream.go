@@ -0,0 +1,124 @@
+package api
+
+import (
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+//
+// UpstreamResourceResolve resolves resource URLs of an upstream service.
+// It is bound to the upstream base URL, so the baseURL argument of its methods is a path relative to the base URL,
+// usually an empty string.
+//
+type UpstreamResourceResolve struct {
+	upstream cfg.UpstreamInfoProvider
+
+	ResourceResolve
+}
+
+//
+// NewUpstream returns the HTTP client and the resource resolver preconfigured for the upstream service.
+//
+func NewUpstream(upstream cfg.UpstreamInfoProvider) (HTTPClient, ResourceResolver) {
+
+	return NewUpstreamHTTPClient(upstream), NewUpstreamResourceResolver(upstream)
+}
+
+//
+// NewUpstreamHTTPClient returns an HTTP client with the upstream timeout and authorization.
+// The client reads them from the upstream on every call, so it follows the config reloads.
+//
+func NewUpstreamHTTPClient(upstream cfg.UpstreamInfoProvider) HTTP {
+
+	return HTTP{upstream: upstream}
+}
+
+//
+// NewUpstreamResourceResolver returns a resource resolver bound to the upstream base URL.
+//
+func NewUpstreamResourceResolver(upstream cfg.UpstreamInfoProvider) UpstreamResourceResolve {
+
+	return UpstreamResourceResolve{upstream: upstream}
+}
+
+//
+// GetCreateEntryResource returns an HTTPResource for the create resource request.
+//
+func (r UpstreamResourceResolve) GetCreateEntryResource(path, resource string) *HTTPResource {
+
+	return r.ResourceResolve.GetCreateEntryResource(r.getBaseURL(path), resource)
+}
+
+//
+// GetReadEntryResource returns an HTTPResource for the get resource request.
+//
+func (r UpstreamResourceResolve) GetReadEntryResource(path, resource, id string) *HTTPResource {
+
+	return r.ResourceResolve.GetReadEntryResource(r.getBaseURL(path), resource, id)
+}
+
+//
+// GetReadEntryResourceWithNestedEntries returns an HTTPResource for the get resource request with nested entries.
+//
+func (r UpstreamResourceResolve) GetReadEntryResourceWithNestedEntries(
+	path, resource, id string,
+	nestedEntries ...string,
+) *HTTPResource {
+
+	return r.ResourceResolve.GetReadEntryResourceWithNestedEntries(r.getBaseURL(path), resource, id, nestedEntries...)
+}
+
+//
+// GetUpdateEntryResource returns an HTTPResource for the update resource request.
+//
+func (r UpstreamResourceResolve) GetUpdateEntryResource(path, resource, id string) *HTTPResource {
+
+	return r.ResourceResolve.GetUpdateEntryResource(r.getBaseURL(path), resource, id)
+}
+
+//
+// GetDeleteEntryResource returns an HTTPResource for the delete resource request.
+//
+func (r UpstreamResourceResolve) GetDeleteEntryResource(path, resource, id string) *HTTPResource {
+
+	return r.ResourceResolve.GetDeleteEntryResource(r.getBaseURL(path), resource, id)
+}
+
+//
+// GetReadEntryNestedCollectionResource returns an HTTPResource for get resource collection entries request.
+//
+func (r UpstreamResourceResolve) GetReadEntryNestedCollectionResource(
+	path, resource, id, collection string,
+) *HTTPResource {
+
+	return r.ResourceResolve.GetReadEntryNestedCollectionResource(r.getBaseURL(path), resource, id, collection)
+}
+
+//
+// GetReadEntryNestedCollectionWithNestedEntriesResource returns an HTTPResource for get resource collection entries
+// with nested entries request.
+//
+func (r UpstreamResourceResolve) GetReadEntryNestedCollectionWithNestedEntriesResource(
+	path, resource, id, collection string,
+	nestedEntries []string,
+) *HTTPResource {
+
+	return r.ResourceResolve.GetReadEntryNestedCollectionWithNestedEntriesResource(
+		r.getBaseURL(path), resource, id, collection, nestedEntries,
+	)
+}
+
+//
+// InvokeResourceAction returns an HTTPResource for invoke resource action request.
+//
+func (r UpstreamResourceResolve) InvokeResourceAction(path, resource, action string) *HTTPResource {
+
+	return r.ResourceResolve.InvokeResourceAction(r.getBaseURL(path), resource, action)
+}
+
+//
+// getBaseURL returns the upstream base URL with the relative path appended.
+//
+func (r UpstreamResourceResolve) getBaseURL(path string) string {
+
+	return r.upstream.GetBaseURL() + path
+}
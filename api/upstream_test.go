@@ -0,0 +1,62 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+func TestNewUpstream_WithARegisteredUpstream_CallsTheBoundResource(t *testing.T) {
+	var authorization, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization, path = r.Header.Get("Authorization"), r.URL.Path
+		w.Write([]byte("response"))
+	}))
+	defer server.Close()
+	config := cfg.NewConfig(cfg.NewMapProvider("map", map[string]string{
+		"CARDS_URL":      server.URL,
+		"CARDS_VERSION":  "v5",
+		"CARDS_AUTH_KEY": "key",
+	}))
+	config.RegisterUpstream("cards")
+	config.Parse()
+	upstream, _ := config.GetUpstream("cards")
+
+	client, resolver := NewUpstream(upstream)
+	response, err := client.RawCall(resolver.GetReadEntryResource("", "card", "id"))
+
+	assert.Empty(t, err)
+	assert.Equal(t, []byte("response"), response)
+	assert.Equal(t, "Bearer key", authorization)
+	assert.Equal(t, "/v5/card/id", path)
+}
+
+func TestNewUpstreamHTTPClient_WithARotatedAuthKey_SendsTheReloadedKey(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "config.json")
+	ioutil.WriteFile(path, []byte(`{"CARDS_URL": "`+server.URL+`", "CARDS_AUTH_KEY": "old"}`), 0600)
+	config := cfg.NewConfig(cfg.NewMapProvider("map", map[string]string{cfg.ConfigFile: path}))
+	config.Register(cfg.ConfigFile)
+	config.RegisterUpstream("cards")
+	config.Parse()
+	upstream, _ := config.GetUpstream("cards")
+
+	client, resolver := NewUpstream(upstream)
+	ioutil.WriteFile(path, []byte(`{"CARDS_URL": "`+server.URL+`", "CARDS_AUTH_KEY": "new"}`), 0600)
+	errReloading := config.Reload()
+	_, err := client.RawCall(resolver.GetReadEntryResource("", "card", "id"))
+
+	assert.Empty(t, errReloading)
+	assert.Empty(t, err)
+	assert.Equal(t, "Bearer new", authorization)
+}
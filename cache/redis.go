@@ -14,7 +14,7 @@ import (
 // Redis client instance.
 //
 type Redis struct {
-	client *redis.Client // TODO:: I want to get rid of this hardcoded dependency here!!!
+	client redis.UniversalClient
 	logger log.Logger
 }
 
@@ -26,6 +26,14 @@ func NewRedisClient(c *redis.Client, l log.Logger) *Redis {
 	return &Redis{c, l}
 }
 
+//
+// NewRedisUniversalClient returns a new Redis client instance for a single server, sentinel or cluster client.
+//
+func NewRedisUniversalClient(c redis.UniversalClient, l log.Logger) *Redis {
+
+	return &Redis{c, l}
+}
+
 //
 // SetKey sets a value for key for duration.
 //
@@ -64,3 +72,22 @@ func (r *Redis) GetKey(key string) ([]byte, error) {
 
 	return []byte(result), nil
 }
+
+//
+// Ping checks the redis server is reachable.
+//
+func (r *Redis) Ping() error {
+	if err := r.client.Ping().Err(); nil != err {
+		return errors.WithMessage(err, "kit.cache@Redis.Ping")
+	}
+
+	return nil
+}
+
+//
+// GetHealthName returns the health dependency name, the same as health.DependencyRedis.
+//
+func (r *Redis) GetHealthName() string {
+
+	return "redis"
+}
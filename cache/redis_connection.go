@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"crypto/tls"
+
+	"github.com/go-redis/redis"
+
+	"github.com/ameteiko/golang-kit/cfg"
+	"github.com/ameteiko/golang-kit/log"
+)
+
+//
+// NewRedisFromConnectionInfo returns a new Redis client instance for the Redis configuration parameter.
+// The client type depends on the connection info: a sentinel-backed failover client, a cluster client or a single
+// server client.
+//
+func NewRedisFromConnectionInfo(info cfg.RedisConnectionInfoProvider, l log.Logger) *Redis {
+
+	return NewRedisUniversalClient(newRedisUniversalClient(info), l)
+}
+
+//
+// newRedisUniversalClient returns a new go-redis client for the connection info.
+// The TLS server name of the sentinel and cluster clients is left empty, so it is taken from every dialed address.
+//
+func newRedisUniversalClient(info cfg.RedisConnectionInfoProvider) redis.UniversalClient {
+	var tlsConfig *tls.Config
+	if info.IsTLS() {
+		tlsConfig = &tls.Config{}
+		if !info.IsSentinel() && !info.IsCluster() {
+			tlsConfig.ServerName = info.GetHost()
+		}
+	}
+
+	if info.IsSentinel() {
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    info.GetSentinelMaster(),
+			SentinelAddrs: info.GetAddresses(),
+			Password:      info.GetPassword(),
+			DB:            info.GetDB(),
+			DialTimeout:   info.GetDialTimeout(),
+			ReadTimeout:   info.GetReadTimeout(),
+			WriteTimeout:  info.GetWriteTimeout(),
+			PoolSize:      info.GetPoolSize(),
+			TLSConfig:     tlsConfig,
+		})
+	}
+
+	if info.IsCluster() {
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        info.GetAddresses(),
+			Password:     info.GetPassword(),
+			DialTimeout:  info.GetDialTimeout(),
+			ReadTimeout:  info.GetReadTimeout(),
+			WriteTimeout: info.GetWriteTimeout(),
+			PoolSize:     info.GetPoolSize(),
+			TLSConfig:    tlsConfig,
+		})
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:         info.GetAddresses()[0],
+		Password:     info.GetPassword(),
+		DB:           info.GetDB(),
+		DialTimeout:  info.GetDialTimeout(),
+		ReadTimeout:  info.GetReadTimeout(),
+		WriteTimeout: info.GetWriteTimeout(),
+		PoolSize:     info.GetPoolSize(),
+		TLSConfig:    tlsConfig,
+	})
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+func TestNewRedisUniversalClient_WithAClusterConnectionString_ReturnsAClusterClient(t *testing.T) {
+	client := newRedisUniversalClient(getRedisConnectionInfo(t, "redis://host1.com:6379,host2.com:6379?cluster=true"))
+	defer client.Close()
+
+	_, ok := client.(*redis.ClusterClient)
+
+	assert.True(t, ok)
+}
+
+func TestNewRedisUniversalClient_WithATLSClusterConnectionString_LeavesTheServerNameToTheAddresses(t *testing.T) {
+	client := newRedisUniversalClient(getRedisConnectionInfo(t, "rediss://host1.com:6379,host2.com:6379?cluster=true"))
+	defer client.Close()
+
+	clusterClient, ok := client.(*redis.ClusterClient)
+
+	assert.True(t, ok)
+	assert.NotNil(t, clusterClient.Options().TLSConfig)
+	assert.Equal(t, "", clusterClient.Options().TLSConfig.ServerName)
+}
+
+func TestNewRedisUniversalClient_WithASingleServerConnectionString_ReturnsAClient(t *testing.T) {
+	client := newRedisUniversalClient(getRedisConnectionInfo(t, "rediss://:password@host.com:6380/2?pool_size=5"))
+	defer client.Close()
+
+	redisClient, ok := client.(*redis.Client)
+
+	assert.True(t, ok)
+	assert.Equal(t, "host.com:6380", redisClient.Options().Addr)
+	assert.Equal(t, "password", redisClient.Options().Password)
+	assert.Equal(t, 2, redisClient.Options().DB)
+	assert.Equal(t, 5, redisClient.Options().PoolSize)
+	assert.Equal(t, "host.com", redisClient.Options().TLSConfig.ServerName)
+}
+
+//
+// getRedisConnectionInfo returns the parsed Redis connection info.
+//
+func getRedisConnectionInfo(t *testing.T, connectionString string) cfg.RedisConnectionInfoProvider {
+	config := cfg.NewConfig(cfg.NewMapProvider("map", map[string]string{"REDIS": connectionString}))
+	config.RegisterRedisParameter("REDIS")
+	if err := config.Parse(); nil != err {
+		t.Fatal(err)
+	}
+	info, _ := config.GetRedisParameter("REDIS")
+
+	return info
+}
@@ -4,11 +4,15 @@
 package cfg
 
 import (
+	"fmt"
+	"io"
+	"math"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/namsral/flag"
-
 	"github.com/ameteiko/golang-kit/errors"
 )
 
@@ -27,6 +31,7 @@ const (
 
 //
 // Predefined configuration parameter types.
+// The DEVPORTAL and CARDS parameters are deprecated in favour of the upstreams registered with RegisterUpstream.
 //
 const (
 	DevPortalURL Parameter = "DEVPORTAL_URL"
@@ -36,6 +41,16 @@ const (
 	Cards4CardID    = "CARDS4_CARD_ID"
 
 	Cards5URL = "CARDS5_URL"
+
+	ConfigFile = "CONFIG_FILE"
+
+	HTTPReadTimeout  = "HTTP_READ_TIMEOUT"
+	HTTPWriteTimeout = "HTTP_WRITE_TIMEOUT"
+
+	HealthConfigToken = "HEALTH_CONFIG_TOKEN"
+
+	ConfigPrivateKey         = "CONFIG_PRIVATE_KEY"
+	ConfigPrivateKeyPassword = "CONFIG_PRIVATE_KEY_PASSWORD"
 )
 
 //
@@ -46,12 +61,17 @@ type Configer interface {
 	//
 	// Register registers a predefined configuration parameter for the application.
 	//
-	Register(Parameter)
+	Register(Parameter, ...ParameterOption)
 
 	//
 	// GetValue returns a string parameter value.
 	//
 	GetValue(Parameter) string
+
+	//
+	// GetSource returns the name of the provider the parameter value came from.
+	//
+	GetSource(Parameter) string
 }
 
 //
@@ -61,7 +81,7 @@ type CustomParamsConfiger interface {
 	//
 	// RegisterStringParameter registers a custom string configuration parameter.
 	//
-	RegisterStringParameter(Parameter)
+	RegisterStringParameter(Parameter, ...ParameterOption)
 
 	//
 	// GetStringParameter returns a parameter info.
@@ -71,7 +91,7 @@ type CustomParamsConfiger interface {
 	//
 	// RegisterBase64Parameter registers a custom base64-encoded configuration parameter.
 	//
-	RegisterBase64Parameter(Parameter)
+	RegisterBase64Parameter(Parameter, ...ParameterOption)
 
 	//
 	// GetBase64Parameter returns a Base64-encoded parameter info.
@@ -81,7 +101,7 @@ type CustomParamsConfiger interface {
 	//
 	// RegisterURLParameter registers a custom URL configuration parameter.
 	//
-	RegisterURLParameter(Parameter)
+	RegisterURLParameter(Parameter, ...ParameterOption)
 
 	//
 	// GetURLParameter returns a URL parameter info.
@@ -91,17 +111,27 @@ type CustomParamsConfiger interface {
 	//
 	// RegisterCassandraParameter registers Cassandra configuration parameter.
 	//
-	RegisterCassandraParameter(Parameter)
+	RegisterCassandraParameter(Parameter, ...ParameterOption)
 
 	//
 	// GetCassandraParameter returns Cassandra configuration parameter value.
 	//
 	GetCassandraParameter(Parameter) (CassandraConnectionInfoProvider, error)
 
+	//
+	// RegisterPostgresParameter registers PostgreSQL configuration parameter.
+	//
+	RegisterPostgresParameter(Parameter, ...ParameterOption)
+
+	//
+	// GetPostgresParameter returns PostgreSQL configuration parameter value.
+	//
+	GetPostgresParameter(Parameter) (PostgresConnectionInfoProvider, error)
+
 	//
 	// RegisterRedisParameter registers Redis configuration parameter.
 	//
-	RegisterRedisParameter(Parameter)
+	RegisterRedisParameter(Parameter, ...ParameterOption)
 
 	//
 	// GetRedisParameter returns Redis configuration parameter value.
@@ -111,17 +141,94 @@ type CustomParamsConfiger interface {
 	//
 	// RegisterLogParameter registers log configuration parameter.
 	//
-	RegisterLogParameter(Parameter)
+	RegisterLogParameter(Parameter, ...ParameterOption)
 
 	//
 	// GetLogParameter returns log configuration parameter value.
 	//
 	GetLogParameter(Parameter) (LogInfoProvider, error)
+
+	//
+	// RegisterIntParameter registers an integer configuration parameter.
+	//
+	RegisterIntParameter(Parameter, ...ParameterOption)
+
+	//
+	// GetIntParameter returns an integer parameter info.
+	//
+	GetIntParameter(Parameter) (IntInfoProvider, error)
+
+	//
+	// RegisterBoolParameter registers a boolean configuration parameter.
+	//
+	RegisterBoolParameter(Parameter, ...ParameterOption)
+
+	//
+	// GetBoolParameter returns a boolean parameter info.
+	//
+	GetBoolParameter(Parameter) (BoolInfoProvider, error)
+
+	//
+	// RegisterDurationParameter registers a duration configuration parameter.
+	//
+	RegisterDurationParameter(Parameter, ...ParameterOption)
+
+	//
+	// GetDurationParameter returns a duration parameter info.
+	//
+	GetDurationParameter(Parameter) (DurationInfoProvider, error)
+
+	//
+	// RegisterListParameter registers a comma separated list configuration parameter.
+	//
+	RegisterListParameter(Parameter, ...ParameterOption)
+
+	//
+	// GetListParameter returns a list parameter info.
+	//
+	GetListParameter(Parameter) (ListInfoProvider, error)
+
+	//
+	// RegisterConfigFileParameter registers a configuration file path parameter.
+	//
+	RegisterConfigFileParameter(Parameter, ...ParameterOption)
+
+	//
+	// GetConfigFileParameter returns a configuration file path parameter info.
+	//
+	GetConfigFileParameter(Parameter) (ConfigFileInfoProvider, error)
+
+	//
+	// RegisterParameter registers an application defined configuration parameter type created by the factory.
+	//
+	RegisterParameter(Parameter, ParameterFactory, ...ParameterOption)
+
+	//
+	// GetCustomParameter returns an application defined parameter info.
+	//
+	GetCustomParameter(Parameter) (CustomParameter, error)
+
+	//
+	// Bind registers the parameters for the tagged fields of the target struct and populates them on Parse.
+	//
+	Bind(interface{}) error
+
+	//
+	// RegisterUpstream registers the upstream service parameters.
+	//
+	RegisterUpstream(Upstream, ...UpstreamOption)
+
+	//
+	// GetUpstream returns an upstream service info.
+	//
+	GetUpstream(Upstream) (UpstreamInfoProvider, error)
 }
 
 //
 // CommonsConfiger returns all the common application parameters.
 //
+// Deprecated: register the upstream services with RegisterUpstream and get them with GetUpstream.
+//
 type CommonsConfiger interface {
 	//
 	// GetCards5URL returns Cards v5 url.
@@ -150,33 +257,60 @@ type CommonsConfiger interface {
 type DefaultsConfiger interface {
 	//
 	// GetHTTPReadTimeout a default HTTP read timeout.
+	// It is overridden by the HTTPReadTimeout parameter value.
 	//
 	GetHTTPReadTimeout() time.Duration
 
 	//
 	// GetHTTPWriteTimeout a default HTTP write timeout.
+	// It is overridden by the HTTPWriteTimeout parameter value.
 	//
 	GetHTTPWriteTimeout() time.Duration
 }
 
+//
+// defaultParameters are the optional parameters registered by every configuration.
+//
+var defaultParameters = []Parameter{HTTPReadTimeout, HTTPWriteTimeout}
+
 //
 // Config object provides an access for all the application configuration parameters.
 //
 type Config struct {
-	parameters       map[Parameter]ParameterInfoProvider
-	httpReadTimeout  time.Duration
-	httpWriteTimeout time.Duration
+	parameters     map[Parameter]ParameterInfoProvider
+	templates      map[Parameter]ParameterInfoProvider
+	sources        map[Parameter]string
+	providers      []Provider
+	bindings       []binding
+	parseProviders []Provider
+	listeners      map[Parameter][]ChangeListener
+	groups         map[string]map[Parameter]bool
+	helpOutput     io.Writer
+	upstreams      map[Upstream]*UpstreamInfo
+	mu             sync.RWMutex
+	reloadMu       sync.Mutex
 }
 
 //
-// NewConfig returns an instance of Config object
+// NewConfig returns an instance of Config object.
+// Parameter values are taken from the providers in the passed order, the later providers take precedence over the
+// earlier ones. Without providers the values are taken from the environment variables overridden by the command-line
+// flags. The optional defaultParameters are registered for every configuration.
 //
-func NewConfig() *Config {
+func NewConfig(providers ...Provider) *Config {
 	config := Config{
-		httpReadTimeout:  DefaultHTTPReadTimeout,
-		httpWriteTimeout: DefaultHTTPWriteTimeout,
+		providers:  providers,
+		helpOutput: os.Stderr,
 	}
 	config.parameters = make(map[Parameter]ParameterInfoProvider)
+	config.templates = make(map[Parameter]ParameterInfoProvider)
+	config.sources = make(map[Parameter]string)
+	config.listeners = make(map[Parameter][]ChangeListener)
+	config.groups = make(map[string]map[Parameter]bool)
+	config.upstreams = make(map[Upstream]*UpstreamInfo)
+	for _, param := range defaultParameters {
+		config.Register(param, Optional())
+	}
 
 	return &config
 }
@@ -184,67 +318,177 @@ func NewConfig() *Config {
 //
 // Register registers a configuration parameter for the application.
 //
-func (c *Config) Register(param Parameter) {
-	c.parameters[param] = getConfigParameterEntry(param)
+func (c *Config) Register(param Parameter, options ...ParameterOption) {
+	c.register(getConfigParameterEntry(param), options)
 }
 
 //
 // RegisterStringParameter registers a custom string configuration parameter.
 //
-func (c *Config) RegisterStringParameter(param Parameter) {
-	c.parameters[param] = newStringParameter(param)
+func (c *Config) RegisterStringParameter(param Parameter, options ...ParameterOption) {
+	c.register(newStringParameter(param), options)
 }
 
 //
 // RegisterCassandraParameter registers a cassandra connection configuration parameter.
+// Unknown connection string options are rejected, see CassandraConnectionInfo.
+//
+func (c *Config) RegisterCassandraParameter(param Parameter, options ...ParameterOption) {
+	c.register(newCassandraParameter(param), options)
+}
+
+//
+// RegisterPostgresParameter registers a PostgreSQL connection configuration parameter.
 //
-func (c *Config) RegisterCassandraParameter(param Parameter) {
-	c.parameters[param] = newCassandraParameter(param)
+func (c *Config) RegisterPostgresParameter(param Parameter, options ...ParameterOption) {
+	c.register(newPostgresParameter(param), options)
 }
 
 //
 // RegisterRedisParameter registers redis connection configuration parameter.
 //
-func (c *Config) RegisterRedisParameter(param Parameter) {
-	c.parameters[param] = newRedisParameter(param)
+func (c *Config) RegisterRedisParameter(param Parameter, options ...ParameterOption) {
+	c.register(newRedisParameter(param), options)
 }
 
 //
 // RegisterBase64Parameter registers a custom base64-encoded configuration parameter.
 //
-func (c *Config) RegisterBase64Parameter(param Parameter) {
-	c.parameters[param] = newBase64Parameter(param)
+func (c *Config) RegisterBase64Parameter(param Parameter, options ...ParameterOption) {
+	c.register(newBase64Parameter(param), options)
 }
 
 //
 // RegisterURLParameter registers a custom URL configuration parameter.
 //
-func (c *Config) RegisterURLParameter(param Parameter) {
-	c.parameters[param] = newURLParameter(param)
+func (c *Config) RegisterURLParameter(param Parameter, options ...ParameterOption) {
+	c.register(newURLParameter(param), options)
 }
 
 //
 // RegisterLogParameter registers log configuration parameter.
 //
-func (c *Config) RegisterLogParameter(param Parameter) {
-	c.parameters[param] = newLogParameter(param)
+func (c *Config) RegisterLogParameter(param Parameter, options ...ParameterOption) {
+	c.register(newLogParameter(param), options)
+}
+
+//
+// RegisterIntParameter registers an integer configuration parameter.
+//
+func (c *Config) RegisterIntParameter(param Parameter, options ...ParameterOption) {
+	c.register(newIntParameter(param), options)
+}
+
+//
+// RegisterBoolParameter registers a boolean configuration parameter.
+//
+func (c *Config) RegisterBoolParameter(param Parameter, options ...ParameterOption) {
+	c.register(newBoolParameter(param), options)
+}
+
+//
+// RegisterDurationParameter registers a duration configuration parameter.
+//
+func (c *Config) RegisterDurationParameter(param Parameter, options ...ParameterOption) {
+	c.register(newDurationParameter(param), options)
+}
+
+//
+// RegisterListParameter registers a comma separated list configuration parameter.
+//
+func (c *Config) RegisterListParameter(param Parameter, options ...ParameterOption) {
+	c.register(newListParameter(param), options)
+}
+
+//
+// RegisterConfigFileParameter registers a configuration file path parameter.
+// The parameter values found in the file have the lowest precedence: they are used only for the parameters none of
+// the providers has set.
+//
+func (c *Config) RegisterConfigFileParameter(param Parameter, options ...ParameterOption) {
+	c.register(newConfigFileParameter(param), options)
+}
+
+//
+// register registers a configuration parameter entry with the registration options applied.
+// A copy of the entry is kept as the template of the fresh entries built on every reload.
+//
+func (c *Config) register(parameterEntry ParameterInfoProvider, options []ParameterOption) {
+	for _, option := range options {
+		option(parameterEntry)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.parameters[Parameter(parameterEntry.GetName())] = parameterEntry
+	c.templates[Parameter(parameterEntry.GetName())] = cloneParameterEntry(parameterEntry)
+}
+
+//
+// getParameterEntry returns the current registered parameter entry or nil if the parameter is not registered.
+// Entries are never changed after a reload published them, so the returned entry is safe to read without locking.
+//
+func (c *Config) getParameterEntry(param Parameter) ParameterInfoProvider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.parameters[param]
 }
 
 //
 // Parse parses all application configuration parameters.
+// Parse sets the values of the registered entries in place, so it must be done before the parameters are read
+// concurrently; use Reload to change the configuration of a running application.
+// Without custom providers it parses the process environment variables and command-line arguments.
+// Values with the EncryptedValuePrefix are decrypted with the private key of the registered ConfigPrivateKey parameter.
 //
 func (c *Config) Parse() error {
-	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
-	for param, paramEntry := range c.parameters {
-		func(parameterValue *string) {
-			paramName := string(param)
-			flags.StringVar(parameterValue, paramName, "", "")
-		}(paramEntry.GetValueLink())
-	}
-	flags.Parse(os.Args[1:])
+	if 0 == len(c.providers) {
+		return c.ParseFrom(os.Args[1:], getEnvironment())
+	}
+
+	return c.parse(c.providers)
+}
+
+//
+// ParseFrom parses all application configuration parameters from the passed environment variables overridden by the
+// command-line arguments (without the program name). It never exits the process: an unknown flag is reported as
+// ErrConfigFlagsAreIncorrect and the -h/-help flag as ErrConfigHelpIsRequested after the help is written to the help
+// output, see SetHelpOutput.
+//
+func (c *Config) ParseFrom(args []string, env map[string]string) error {
+
+	return c.parse([]Provider{NewMapProvider(SourceEnv, env), NewFlagProvider(args)})
+}
+
+//
+// SetHelpOutput sets the writer the command-line help is written to when it is requested with the -h/-help flag.
+// The help is written to the standard error by default.
+//
+func (c *Config) SetHelpOutput(w io.Writer) {
+	c.helpOutput = w
+}
+
+//
+// parse sets the parameter values from the providers and validates them.
+//
+func (c *Config) parse(providers []Provider) error {
+	if err := c.provideParameters(providers); nil != err {
+		if isHelpRequested(err) {
+			c.WriteHelp(c.helpOutput)
+		}
+
+		return errors.WithMessage(err, "kit-cfg@Config.Parse")
+	}
+
 	if err := c.validateParameters(); nil != err {
 		return errors.WithMessage(err, "kit-cfg@Config.Parse")
 	}
+	if err := c.populateBindings(c.parameters); nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.Parse")
+	}
+	c.parseProviders = providers
 
 	return nil
 }
@@ -253,6 +497,9 @@ func (c *Config) Parse() error {
 // GetValue returns a parameter value by its name.
 //
 func (c *Config) GetValue(parameter Parameter) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	parameterEntry, ok := c.parameters[parameter]
 	if !ok {
 
@@ -262,27 +509,115 @@ func (c *Config) GetValue(parameter Parameter) string {
 	return parameterEntry.GetValue()
 }
 
+//
+// GetRedactedValue returns a parameter value safe for errors, dumps and logs.
+//
+func (c *Config) GetRedactedValue(parameter Parameter) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	parameterEntry, ok := c.parameters[parameter]
+	if !ok {
+
+		return ""
+	}
+
+	return parameterEntry.GetRedactedValue()
+}
+
+//
+// Redact replaces all secret parameter values and URL passwords in the text.
+// It is intended to be used as a log redactor, see log.Log.SetRedactor.
+//
+func (c *Config) Redact(text string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.redact(text)
+}
+
+//
+// redact replaces all secret parameter values and URL passwords in the text without locking.
+// The longer secrets are replaced first, so a secret containing another one is redacted entirely.
+//
+func (c *Config) redact(text string) string {
+	var secrets []string
+	for _, parameterEntry := range c.parameters {
+		if parameterEntry.IsSecret() && "" != parameterEntry.GetValue() {
+			secrets = append(secrets, parameterEntry.GetValue())
+		}
+	}
+	sort.Slice(secrets, func(i, j int) bool { return len(secrets[i]) > len(secrets[j]) })
+
+	replacements := make([]string, 0, 2*len(secrets))
+	for _, secret := range secrets {
+		replacements = append(replacements, secret, RedactedValue)
+	}
+
+	return redactURLPasswords(strings.NewReplacer(replacements...).Replace(text))
+}
+
+//
+// String returns a dump of all registered parameters with the secret values redacted.
+//
+func (c *Config) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	lines := make([]string, 0, len(c.parameters))
+	for _, param := range c.getParameterNames() {
+		lines = append(lines, fmt.Sprintf("%s=%s", param, c.parameters[param].GetRedactedValue()))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+//
+// GetSource returns the name of the provider the parameter value came from.
+// It returns an empty string if the parameter is not registered or none of the providers has set it.
+//
+func (c *Config) GetSource(parameter Parameter) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.sources[parameter]
+}
+
 //
 // GetHTTPReadTimeout a default HTTP read timeout.
+// It is overridden by the HTTPReadTimeout parameter value.
 //
 func (c *Config) GetHTTPReadTimeout() time.Duration {
 
-	return c.httpReadTimeout
+	return getHTTPTimeout(c.getParameterEntry(HTTPReadTimeout), DefaultHTTPReadTimeout)
 }
 
 //
 // GetHTTPWriteTimeout a default HTTP write timeout.
+// It is overridden by the HTTPWriteTimeout parameter value.
 //
 func (c *Config) GetHTTPWriteTimeout() time.Duration {
 
-	return c.httpWriteTimeout
+	return getHTTPTimeout(c.getParameterEntry(HTTPWriteTimeout), DefaultHTTPWriteTimeout)
+}
+
+//
+// getHTTPTimeout returns the timeout parameter value or the default timeout before parsing or if the parameter was
+// registered again with another type.
+//
+func getHTTPTimeout(parameterEntry ParameterInfoProvider, defaultTimeout time.Duration) time.Duration {
+	if timeout, ok := parameterEntry.(DurationInfoProvider); ok && 0 != timeout.GetDuration() {
+		return timeout.GetDuration()
+	}
+
+	return defaultTimeout
 }
 
 //
 // GetCards5URL returns Virgil Cards service URL.
 //
 func (c *Config) GetCards5URL() (URLInfoProvider, error) {
-	url, ok := c.parameters[Cards5URL].(URLInfoProvider)
+	url, ok := c.getParameterEntry(Cards5URL).(URLInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -297,7 +632,7 @@ func (c *Config) GetCards5URL() (URLInfoProvider, error) {
 // GetCards4ReadURL returns Virgil Cards service read URL.
 //
 func (c *Config) GetCards4ReadURL() (URLInfoProvider, error) {
-	url, ok := c.parameters[Cards4ReadURL].(URLInfoProvider)
+	url, ok := c.getParameterEntry(Cards4ReadURL).(URLInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -312,7 +647,7 @@ func (c *Config) GetCards4ReadURL() (URLInfoProvider, error) {
 // GetCards4CardID returns Virgil Cards service Virgil Card ID.
 //
 func (c *Config) GetCards4CardID() (ParameterInfoProvider, error) {
-	id, ok := c.parameters[Cards4CardID].(ParameterInfoProvider)
+	id, ok := c.getParameterEntry(Cards4CardID).(ParameterInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -327,7 +662,7 @@ func (c *Config) GetCards4CardID() (ParameterInfoProvider, error) {
 // GetCards4PublicKey returns Virgil Cards service public key.
 //
 func (c *Config) GetCards4PublicKey() (Base64StringInfoProvider, error) {
-	key, ok := c.parameters[Cards4PublicKey].(Base64StringInfoProvider)
+	key, ok := c.getParameterEntry(Cards4PublicKey).(Base64StringInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -342,7 +677,7 @@ func (c *Config) GetCards4PublicKey() (Base64StringInfoProvider, error) {
 // GetLogParameter returns log configuration parameter value.
 //
 func (c *Config) GetLogParameter(param Parameter) (LogInfoProvider, error) {
-	parameter, ok := c.parameters[param].(LogInfoProvider)
+	parameter, ok := c.getParameterEntry(param).(LogInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -357,7 +692,7 @@ func (c *Config) GetLogParameter(param Parameter) (LogInfoProvider, error) {
 // GetCassandraParameter returns cassandra config parameter.
 //
 func (c *Config) GetCassandraParameter(param Parameter) (CassandraConnectionInfoProvider, error) {
-	parameter, ok := c.parameters[param].(CassandraConnectionInfoProvider)
+	parameter, ok := c.getParameterEntry(param).(CassandraConnectionInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -368,11 +703,26 @@ func (c *Config) GetCassandraParameter(param Parameter) (CassandraConnectionInfo
 	return parameter, nil
 }
 
+//
+// GetPostgresParameter returns PostgreSQL config parameter.
+//
+func (c *Config) GetPostgresParameter(param Parameter) (PostgresConnectionInfoProvider, error) {
+	parameter, ok := c.getParameterEntry(param).(PostgresConnectionInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetPostgresParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
 //
 // GetRedisParameter returns cassandra config parameter.
 //
 func (c *Config) GetRedisParameter(param Parameter) (RedisConnectionInfoProvider, error) {
-	parameter, ok := c.parameters[param].(RedisConnectionInfoProvider)
+	parameter, ok := c.getParameterEntry(param).(RedisConnectionInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -387,7 +737,7 @@ func (c *Config) GetRedisParameter(param Parameter) (RedisConnectionInfoProvider
 // GetStringParameter returns a string parameter info.
 //
 func (c *Config) GetStringParameter(param Parameter) (ParameterInfoProvider, error) {
-	parameter, ok := c.parameters[param].(ParameterInfoProvider)
+	parameter, ok := c.getParameterEntry(param).(ParameterInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -402,7 +752,7 @@ func (c *Config) GetStringParameter(param Parameter) (ParameterInfoProvider, err
 // GetBase64Parameter returns a Base64-encoded parameter info.
 //
 func (c *Config) GetBase64Parameter(param Parameter) (Base64StringInfoProvider, error) {
-	parameter, ok := c.parameters[param].(Base64StringInfoProvider)
+	parameter, ok := c.getParameterEntry(param).(Base64StringInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -417,7 +767,7 @@ func (c *Config) GetBase64Parameter(param Parameter) (Base64StringInfoProvider,
 // GetURLParameter returns a URL parameter info.
 //
 func (c *Config) GetURLParameter(param Parameter) (URLInfoProvider, error) {
-	url, ok := c.parameters[param].(URLInfoProvider)
+	url, ok := c.getParameterEntry(param).(URLInfoProvider)
 	if !ok {
 		return nil, errors.WithMessage(
 			errors.ErrGetMisregisteredConfigParameter,
@@ -428,21 +778,216 @@ func (c *Config) GetURLParameter(param Parameter) (URLInfoProvider, error) {
 	return url, nil
 }
 
+//
+// provideParameters sets the registered parameter values from the configuration providers.
+//
+func (c *Config) provideParameters(providers []Provider) error {
+	params := c.getParameterNames()
+	for _, param := range params {
+		*c.parameters[param].GetValueLink() = ""
+	}
+	c.sources = make(map[Parameter]string)
+
+	for _, provider := range providers {
+		values, err := provider.Provide(params)
+		if nil != err {
+			return errors.WithMessage(err, `kit-cfg@Config.provideParameters [provider (%s)]`, provider.GetName())
+		}
+
+		for param, value := range values {
+			parameterEntry, ok := c.parameters[param]
+			if !ok {
+				continue
+			}
+			*parameterEntry.GetValueLink() = value
+			c.sources[param] = provider.GetName()
+		}
+	}
+
+	if err := c.provideConfigFileParameters(params); nil != err {
+		return err
+	}
+	c.provideDefaultValues(params)
+
+	return c.decryptParameters(params)
+}
+
+//
+// provideConfigFileParameters sets the parameter values from the files set by the configuration file parameters.
+// File values are set only for the parameters none of the providers has set.
+//
+func (c *Config) provideConfigFileParameters(params []Parameter) error {
+	for _, param := range params {
+		configFile, ok := c.parameters[param].(ConfigFileInfoProvider)
+		if !ok || "" == configFile.GetPath() {
+			continue
+		}
+
+		provider := NewFileProvider(configFile.GetPath())
+		values, err := provider.Provide(params)
+		if nil != err {
+			return errors.WithMessage(err, `kit-cfg@Config.provideConfigFileParameters [parameter (%s)]`, param)
+		}
+
+		for fileParam, value := range values {
+			if _, ok := c.sources[fileParam]; ok {
+				continue
+			}
+			*c.parameters[fileParam].GetValueLink() = value
+			c.sources[fileParam] = provider.GetName()
+		}
+	}
+
+	return nil
+}
+
+//
+// provideDefaultValues sets the default values for the parameters that are left empty by the providers.
+//
+func (c *Config) provideDefaultValues(params []Parameter) {
+	for _, param := range params {
+		parameterEntry := c.parameters[param]
+		if "" != parameterEntry.GetValue() || "" == parameterEntry.GetDefaultValue() {
+			continue
+		}
+		*parameterEntry.GetValueLink() = parameterEntry.GetDefaultValue()
+		c.sources[param] = SourceDefault
+	}
+}
+
+//
+// getEnvironment returns the process environment variables.
+//
+func getEnvironment() map[string]string {
+	env := make(map[string]string)
+	for _, variable := range os.Environ() {
+		if i := strings.Index(variable, "="); 0 < i {
+			env[variable[:i]] = variable[i+1:]
+		}
+	}
+
+	return env
+}
+
+//
+// getParameterNames returns the sorted registered parameter names.
+//
+func (c *Config) getParameterNames() []Parameter {
+	params := make([]Parameter, 0, len(c.parameters))
+	for param := range c.parameters {
+		params = append(params, param)
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i] < params[j] })
+
+	return params
+}
+
+//
+// GetIntParameter returns an integer parameter info.
+//
+func (c *Config) GetIntParameter(param Parameter) (IntInfoProvider, error) {
+	parameter, ok := c.getParameterEntry(param).(IntInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetIntParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
+//
+// GetBoolParameter returns a boolean parameter info.
+//
+func (c *Config) GetBoolParameter(param Parameter) (BoolInfoProvider, error) {
+	parameter, ok := c.getParameterEntry(param).(BoolInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetBoolParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
+//
+// GetDurationParameter returns a duration parameter info.
+//
+func (c *Config) GetDurationParameter(param Parameter) (DurationInfoProvider, error) {
+	parameter, ok := c.getParameterEntry(param).(DurationInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetDurationParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
+//
+// GetListParameter returns a list parameter info.
+//
+func (c *Config) GetListParameter(param Parameter) (ListInfoProvider, error) {
+	parameter, ok := c.getParameterEntry(param).(ListInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetListParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
+//
+// GetConfigFileParameter returns a configuration file path parameter info.
+//
+func (c *Config) GetConfigFileParameter(param Parameter) (ConfigFileInfoProvider, error) {
+	parameter, ok := c.getParameterEntry(param).(ConfigFileInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetConfigFileParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
 //
 // validateParameters validates all registered parameters.
+// It returns a ValidationError with the errors of all invalid parameters ordered by the parameter name.
+// Empty optional parameters are not validated.
 //
 func (c *Config) validateParameters() error {
-	for _, parameterEntry := range c.parameters {
-		if err := parameterEntry.validate(); nil != err {
-			return errors.WithMessage(
+	validationErr := newValidationError()
+	for _, param := range c.getParameterNames() {
+		parameterEntry := c.parameters[param]
+		if parameterEntry.IsOptional() && "" == parameterEntry.GetValue() {
+			continue
+		}
+
+		err := parameterEntry.validate()
+		if nil == err {
+			err = validateCustomParameter(parameterEntry)
+		}
+		if nil != err {
+			validationErr.add(param, errors.WithMessage(
 				err,
 				`kit-cfg@Config.validateParameters [parameter (%s) value (%s)]`,
-				parameterEntry.GetName(), parameterEntry.GetValue(),
-			)
+				parameterEntry.GetName(), parameterEntry.GetRedactedValue(),
+			))
 		}
 	}
 
-	return nil
+	if validationErr.isEmpty() {
+		return nil
+	}
+
+	return validationErr
 }
 
 //
@@ -457,9 +1002,30 @@ func getConfigParameterEntry(parameter Parameter) ParameterInfoProvider {
 	case Cards4ReadURL:
 		return newURLParameter(parameter)
 	case Cards4PublicKey:
-		return newBase64Parameter(parameter)
+		parameterEntry := newBase64Parameter(parameter)
+		parameterEntry.secret = true
+
+		return parameterEntry
 	case Cards4CardID:
 		return newStringParameter(parameter)
+	case ConfigFile:
+		return newConfigFileParameter(parameter)
+	case HTTPReadTimeout:
+		return newHTTPTimeoutParameter(parameter, DefaultHTTPReadTimeout)
+	case HTTPWriteTimeout:
+		return newHTTPTimeoutParameter(parameter, DefaultHTTPWriteTimeout)
+	case HealthConfigToken, ConfigPrivateKeyPassword:
+		parameterEntry := newStringParameter(parameter)
+		parameterEntry.secret = true
+		parameterEntry.optional = true
+
+		return parameterEntry
+	case ConfigPrivateKey:
+		parameterEntry := newBase64Parameter(parameter)
+		parameterEntry.secret = true
+		parameterEntry.optional = true
+
+		return parameterEntry
 	default:
 		return newStringParameter(parameter)
 	}
@@ -499,6 +1065,14 @@ func newCassandraParameter(param Parameter) *CassandraConnectionInfo {
 	return &CassandraConnectionInfo{StringParameter: newStringParameter(param)}
 }
 
+//
+// newPostgresParameter registers a new PostgreSQL parameter.
+//
+func newPostgresParameter(param Parameter) *PostgresConnectionInfo {
+
+	return &PostgresConnectionInfo{StringParameter: newStringParameter(param)}
+}
+
 //
 // newRedisParameter registers a new Redis parameter.
 //
@@ -508,9 +1082,62 @@ func newRedisParameter(param Parameter) *RedisConnectionInfo {
 }
 
 //
-// newCassandraParameter registers a new Cassandra parameter.
+// newLogParameter registers a new log parameter.
 //
 func newLogParameter(param Parameter) *LogInfo {
 
 	return &LogInfo{StringParameter: newStringParameter(param)}
 }
+
+//
+// newConfigFileParameter registers a new configuration file path parameter.
+//
+func newConfigFileParameter(param Parameter) *ConfigFileInfo {
+	parameterEntry := &ConfigFileInfo{StringParameter: newStringParameter(param)}
+	parameterEntry.optional = true
+
+	return parameterEntry
+}
+
+//
+// newIntParameter registers a new integer parameter.
+//
+func newIntParameter(param Parameter) *IntInfo {
+
+	return &IntInfo{StringParameter: newStringParameter(param)}
+}
+
+//
+// newBoolParameter registers a new boolean parameter.
+//
+func newBoolParameter(param Parameter) *BoolInfo {
+
+	return &BoolInfo{StringParameter: newStringParameter(param)}
+}
+
+//
+// newDurationParameter registers a new duration parameter.
+//
+func newDurationParameter(param Parameter) *DurationInfo {
+
+	return &DurationInfo{StringParameter: newStringParameter(param)}
+}
+
+//
+// newListParameter registers a new list parameter.
+//
+func newListParameter(param Parameter) *ListInfo {
+
+	return &ListInfo{StringParameter: newStringParameter(param)}
+}
+
+//
+// newHTTPTimeoutParameter registers a new positive HTTP timeout parameter with the default value.
+//
+func newHTTPTimeoutParameter(param Parameter, defaultTimeout time.Duration) *DurationInfo {
+	parameterEntry := newDurationParameter(param)
+	parameterEntry.defaultValue = defaultTimeout.String()
+	parameterEntry.setDurationRange(time.Nanosecond, time.Duration(math.MaxInt64))
+
+	return parameterEntry
+}
@@ -0,0 +1,248 @@
+package cfg
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// BindTag is a struct field tag for the configuration binding.
+//
+const BindTag = "cfg"
+
+//
+// Binding tag options.
+//
+const (
+	bindOptionSecret   = "secret"
+	bindOptionOptional = "optional"
+	bindOptionDefault  = "default="
+)
+
+//
+// bindParameterFactory creates a parameter entry for the bound struct field.
+//
+type bindParameterFactory func(Parameter) ParameterInfoProvider
+
+//
+// bindParameterFactories lists the parameter types for the supported struct field types.
+// Parameter info fields are set on Bind, the plain value fields are populated on Parse.
+//
+var bindParameterFactories = map[reflect.Type]bindParameterFactory{
+	reflect.TypeOf((*StringParameter)(nil)):         func(p Parameter) ParameterInfoProvider { return newStringParameter(p) },
+	reflect.TypeOf((*Base64StringInfo)(nil)):        func(p Parameter) ParameterInfoProvider { return newBase64Parameter(p) },
+	reflect.TypeOf((*URLInfo)(nil)):                 func(p Parameter) ParameterInfoProvider { return newURLParameter(p) },
+	reflect.TypeOf((*CassandraConnectionInfo)(nil)): func(p Parameter) ParameterInfoProvider { return newCassandraParameter(p) },
+	reflect.TypeOf((*PostgresConnectionInfo)(nil)):  func(p Parameter) ParameterInfoProvider { return newPostgresParameter(p) },
+	reflect.TypeOf((*RedisConnectionInfo)(nil)):     func(p Parameter) ParameterInfoProvider { return newRedisParameter(p) },
+	reflect.TypeOf((*LogInfo)(nil)):                 func(p Parameter) ParameterInfoProvider { return newLogParameter(p) },
+	reflect.TypeOf((*ConfigFileInfo)(nil)):          func(p Parameter) ParameterInfoProvider { return newConfigFileParameter(p) },
+	reflect.TypeOf((*IntInfo)(nil)):                 func(p Parameter) ParameterInfoProvider { return newIntParameter(p) },
+	reflect.TypeOf((*BoolInfo)(nil)):                func(p Parameter) ParameterInfoProvider { return newBoolParameter(p) },
+	reflect.TypeOf((*DurationInfo)(nil)):            func(p Parameter) ParameterInfoProvider { return newDurationParameter(p) },
+	reflect.TypeOf((*ListInfo)(nil)):                func(p Parameter) ParameterInfoProvider { return newListParameter(p) },
+	reflect.TypeOf(""):                              func(p Parameter) ParameterInfoProvider { return newStringParameter(p) },
+	reflect.TypeOf(0):                               func(p Parameter) ParameterInfoProvider { return newIntParameter(p) },
+	reflect.TypeOf(false):                           func(p Parameter) ParameterInfoProvider { return newBoolParameter(p) },
+	reflect.TypeOf(time.Duration(0)):                func(p Parameter) ParameterInfoProvider { return newDurationParameter(p) },
+	reflect.TypeOf([]string(nil)):                   func(p Parameter) ParameterInfoProvider { return newListParameter(p) },
+}
+
+//
+// binding is a struct field populated with the parameter value on Parse and Reload.
+//
+type binding struct {
+	field reflect.Value
+	param Parameter
+}
+
+//
+// bindField is a tagged struct field validated before the registration of its parameter.
+//
+type bindField struct {
+	field   reflect.Value
+	param   Parameter
+	options []ParameterOption
+	factory bindParameterFactory
+}
+
+//
+// Bind registers the parameters for the tagged fields of the target struct.
+// The target must be a pointer to a struct. Fields are tagged as `cfg:"NAME[,secret][,optional][,default=value]"`,
+// the default value takes the rest of the tag, so it must be the last option. Parameter info fields, like *URLInfo or
+// *RedisConnectionInfo, are set on Bind; string, int, bool, time.Duration and []string fields are populated on Parse.
+// Both are set again to the reloaded entries and values on Reload.
+// All the tags are validated before the registration, so a failed Bind leaves the config and the target unchanged.
+//
+func (c *Config) Bind(target interface{}) error {
+
+	return c.bind(target, "")
+}
+
+//
+// bind registers the parameters for the tagged fields of the target struct with the prefixed names.
+//
+func (c *Config) bind(target interface{}, prefix string) error {
+	errMsg := `kit-cfg@Config.Bind [target (%T)]`
+
+	targetValue := reflect.ValueOf(target)
+	if reflect.Ptr != targetValue.Kind() || targetValue.IsNil() || reflect.Struct != targetValue.Elem().Kind() {
+		return errors.WithMessage(ErrConfigBindTargetIsIncorrect, errMsg, target)
+	}
+
+	var fields []bindField
+	entryTypes := make(map[Parameter]reflect.Type)
+	structValue := targetValue.Elem()
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		fieldType := structType.Field(i)
+		tag, ok := fieldType.Tag.Lookup(BindTag)
+		if !ok {
+			continue
+		}
+
+		param, options, err := parseBindTag(tag)
+		if nil != err || "" != fieldType.PkgPath {
+			return errors.WithMessage(ErrConfigBindTagIsIncorrect, errMsg+` [field (%s)]`, target, fieldType.Name)
+		}
+		param = Parameter(prefix) + param
+
+		factory, ok := bindParameterFactories[fieldType.Type]
+		if !ok {
+			return errors.WithMessage(
+				ErrConfigBindFieldTypeIsNotSupported,
+				errMsg+` [field (%s), type (%s)]`,
+				target, fieldType.Name, fieldType.Type,
+			)
+		}
+
+		entryType := reflect.TypeOf(factory(param))
+		registeredType, ok := entryTypes[param]
+		if registered := c.getParameterEntry(param); !ok && nil != registered {
+			registeredType, ok = reflect.TypeOf(registered), true
+		}
+		if ok && registeredType != entryType {
+			return errors.WithMessage(
+				ErrConfigBindParameterTypeIsIncorrect,
+				errMsg+` [field (%s), parameter (%s), registered type (%s)]`,
+				target, fieldType.Name, param, registeredType,
+			)
+		}
+		entryTypes[param] = entryType
+
+		fields = append(fields, bindField{field: structValue.Field(i), param: param, options: options, factory: factory})
+	}
+
+	for _, f := range fields {
+		parameterEntry := f.factory(f.param)
+		c.register(parameterEntry, f.options)
+		if "" != prefix {
+			c.addGroupParameter(prefix, f.param)
+		}
+		if reflect.Ptr == f.field.Kind() {
+			f.field.Set(reflect.ValueOf(parameterEntry))
+		}
+		c.mu.Lock()
+		c.bindings = append(c.bindings, binding{field: f.field, param: f.param})
+		c.mu.Unlock()
+	}
+
+	return nil
+}
+
+//
+// populateBindings sets the parameter entries and their values to the bound struct fields.
+// It returns an error and sets no field if a parameter was registered again with a value of another type.
+//
+func (c *Config) populateBindings(parameters map[Parameter]ParameterInfoProvider) error {
+	errMsg := `kit-cfg@Config.populateBindings [parameter (%s), field type (%s)]`
+
+	values := make([]reflect.Value, len(c.bindings))
+	for i, b := range c.bindings {
+		value := getBindingValue(b, parameters[b.param])
+		if nil == value {
+			continue
+		}
+		if !reflect.TypeOf(value).AssignableTo(b.field.Type()) {
+			return errors.WithMessage(ErrConfigBindParameterTypeIsIncorrect, errMsg, b.param, b.field.Type())
+		}
+		values[i] = reflect.ValueOf(value)
+	}
+
+	for i, b := range c.bindings {
+		if values[i].IsValid() {
+			b.field.Set(values[i])
+		}
+	}
+
+	return nil
+}
+
+//
+// getBindingValue returns the value of the parameter entry for the bound field, the entry itself for the parameter
+// info fields.
+//
+func getBindingValue(b binding, parameterEntry ParameterInfoProvider) interface{} {
+	if nil == parameterEntry {
+		return nil
+	}
+	if reflect.Ptr == b.field.Kind() {
+		return parameterEntry
+	}
+
+	switch parameterEntry := parameterEntry.(type) {
+	case IntInfoProvider:
+		return parameterEntry.GetInt()
+	case BoolInfoProvider:
+		return parameterEntry.GetBool()
+	case DurationInfoProvider:
+		return parameterEntry.GetDuration()
+	case ListInfoProvider:
+		return parameterEntry.GetList()
+	}
+
+	return parameterEntry.GetValue()
+}
+
+//
+// parseBindTag returns the parameter name and the registration options of the binding tag.
+//
+func parseBindTag(tag string) (Parameter, []ParameterOption, error) {
+	parts := strings.SplitN(tag, ",", 2)
+	name := strings.TrimSpace(parts[0])
+	if "" == name {
+		return "", nil, ErrConfigBindTagIsIncorrect
+	}
+
+	var options []ParameterOption
+	rest := ""
+	if 2 == len(parts) {
+		rest = parts[1]
+	}
+	for "" != rest {
+		if strings.HasPrefix(rest, bindOptionDefault) {
+			options = append(options, Default(strings.TrimPrefix(rest, bindOptionDefault)))
+			break
+		}
+
+		parts = strings.SplitN(rest, ",", 2)
+		switch strings.TrimSpace(parts[0]) {
+		case bindOptionSecret:
+			options = append(options, Secret())
+		case bindOptionOptional:
+			options = append(options, Optional())
+		default:
+			return "", nil, ErrConfigBindTagIsIncorrect
+		}
+
+		rest = ""
+		if 2 == len(parts) {
+			rest = parts[1]
+		}
+	}
+
+	return Parameter(name), options, nil
+}
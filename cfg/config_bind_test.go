@@ -0,0 +1,123 @@
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+//
+// bindTestConfig is a testing bound configuration.
+//
+type bindTestConfig struct {
+	Redis   *RedisConnectionInfo `cfg:"BIND_TEST_REDIS,secret"`
+	Name    string               `cfg:"BIND_TEST_NAME,optional"`
+	Workers int                  `cfg:"BIND_TEST_WORKERS,default=4"`
+	Debug   bool                 `cfg:"BIND_TEST_DEBUG,default=false"`
+	Timeout time.Duration        `cfg:"BIND_TEST_TIMEOUT"`
+	Hosts   []string             `cfg:"BIND_TEST_HOSTS,default=a.com,b.com"`
+	Ignored string
+}
+
+func TestBind_WithATaggedStruct_PopulatesTheFieldsOnParse(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		"BIND_TEST_REDIS":   "redis://:password@host.com:6379",
+		"BIND_TEST_TIMEOUT": "2s",
+	}))
+	target := bindTestConfig{}
+
+	errBind := config.Bind(&target)
+	errParsing := config.Parse()
+
+	assert.Empty(t, errBind)
+	assert.Empty(t, errParsing)
+	assert.Equal(t, "host.com", target.Redis.GetHost())
+	assert.True(t, target.Redis.IsSecret())
+	assert.Equal(t, "", target.Name)
+	assert.Equal(t, 4, target.Workers)
+	assert.False(t, target.Debug)
+	assert.Equal(t, 2*time.Second, target.Timeout)
+	assert.Equal(t, []string{"a.com", "b.com"}, target.Hosts)
+	assert.Equal(t, 6+len(defaultParameters), len(config.getParameterNames()))
+}
+
+func TestBind_WithANotStructPointer_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+
+	err := config.Bind(bindTestConfig{})
+
+	helper.AssertError(t, ErrConfigBindTargetIsIncorrect, err)
+}
+
+func TestBind_WithAnUnsupportedFieldType_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+	target := struct {
+		Rate float64 `cfg:"BIND_TEST_RATE"`
+	}{}
+
+	err := config.Bind(&target)
+
+	helper.AssertError(t, ErrConfigBindFieldTypeIsNotSupported, err)
+}
+
+func TestBind_WithAnUnknownTagOption_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+	target := struct {
+		Name string `cfg:"BIND_TEST_NAME,required"`
+	}{}
+
+	err := config.Bind(&target)
+
+	helper.AssertError(t, ErrConfigBindTagIsIncorrect, err)
+}
+
+func TestBind_WithAFailingLaterField_LeavesTheConfigUnchanged(t *testing.T) {
+	config := NewConfig()
+	target := struct {
+		Redis *RedisConnectionInfo `cfg:"BIND_TEST_REDIS"`
+		Name  string               `cfg:"BIND_TEST_NAME"`
+		Rate  float64              `cfg:"BIND_TEST_RATE"`
+	}{}
+
+	err := config.Bind(&target)
+
+	helper.AssertError(t, ErrConfigBindFieldTypeIsNotSupported, err)
+	assert.Equal(t, defaultParameters, config.getParameterNames())
+	assert.Empty(t, config.bindings)
+	assert.Nil(t, target.Redis)
+}
+
+func TestBind_WithAParameterBoundWithAnotherType_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+	first := struct {
+		S string `cfg:"BIND_TEST_DUP"`
+	}{}
+	second := struct {
+		I int `cfg:"BIND_TEST_DUP"`
+	}{}
+
+	errFirst := config.Bind(&first)
+	errSecond := config.Bind(&second)
+
+	assert.Empty(t, errFirst)
+	helper.AssertError(t, ErrConfigBindParameterTypeIsIncorrect, errSecond)
+	assert.IsType(t, &StringParameter{}, config.getParameterEntry("BIND_TEST_DUP"))
+}
+
+func TestParse_WithABoundParameterRegisteredAgainWithAnotherType_ReturnsAnError(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"BIND_TEST_DUP": "value"}))
+	target := struct {
+		I int `cfg:"BIND_TEST_DUP,optional"`
+	}{}
+
+	errBind := config.Bind(&target)
+	config.RegisterStringParameter("BIND_TEST_DUP")
+	err := config.Parse()
+
+	assert.Empty(t, errBind)
+	helper.AssertError(t, ErrConfigBindParameterTypeIsIncorrect, err)
+	assert.Equal(t, 0, target.I)
+}
@@ -0,0 +1,293 @@
+package cfg
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Configuration check constants.
+//
+const (
+	CheckProbeFlag           = "-probe"
+	DefaultCheckProbeTimeout = time.Second * 5
+	DefaultCassandraPort     = 9042
+	DefaultPostgresPort      = "5432"
+)
+
+//
+// Prober probes the connectivity of the parameter dependency, like a database or a web service.
+//
+type Prober func(parameterEntry ParameterInfoProvider, timeout time.Duration) error
+
+//
+// ProbeResult is a result of the parameter dependency connectivity probe.
+//
+type ProbeResult struct {
+	Parameter string  `json:"parameter"`
+	Type      string  `json:"type"`
+	Available bool    `json:"available"`
+	Latency   float64 `json:"latency"`
+	Error     string  `json:"error,omitempty"`
+}
+
+//
+// CheckReport is a configuration check report.
+//
+type CheckReport struct {
+	Valid      bool             `json:"valid"`
+	Error      string           `json:"error,omitempty"`
+	Parameters []ParameterState `json:"parameters"`
+	Probes     []ProbeResult    `json:"probes,omitempty"`
+}
+
+//
+// CheckOption is a configuration check option.
+//
+type CheckOption func(*checkOptions)
+
+//
+// checkOptions keeps the configuration check options.
+//
+type checkOptions struct {
+	probe   bool
+	timeout time.Duration
+	probers map[string]Prober
+}
+
+//
+// CheckWithProbes enables the connectivity probes of the valid parameters.
+//
+func CheckWithProbes() CheckOption {
+
+	return func(o *checkOptions) {
+		o.probe = true
+	}
+}
+
+//
+// CheckProbeTimeout sets the connectivity probe timeout.
+//
+func CheckProbeTimeout(timeout time.Duration) CheckOption {
+
+	return func(o *checkOptions) {
+		o.timeout = timeout
+	}
+}
+
+//
+// CheckProber sets the connectivity prober of the parameter type, see the Type constants.
+// By default the redis, cassandra, postgres and url parameters are probed by connecting to their hosts.
+//
+func CheckProber(parameterType string, prober Prober) CheckOption {
+
+	return func(o *checkOptions) {
+		o.probers[parameterType] = prober
+	}
+}
+
+//
+// RunCheck parses and validates the registered parameters without starting the service, writes the JSON report and
+// returns the process exit code: 0 if the configuration is valid and all the probes succeeded, 1 otherwise.
+// It is intended for a "config check" subcommand, the args are the configuration flags of the subcommand. The -probe
+// flag enables the connectivity probes.
+//
+func RunCheck(config *Config, args []string, w io.Writer, options ...CheckOption) int {
+	var configArgs []string
+	for _, arg := range args {
+		if CheckProbeFlag == arg || "-"+CheckProbeFlag == arg {
+			options = append(options, CheckWithProbes())
+			continue
+		}
+		configArgs = append(configArgs, arg)
+	}
+
+	report := config.Check(configArgs, options...)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); nil != err || !report.isSuccessful() {
+		return 1
+	}
+
+	return 0
+}
+
+//
+// Check parses and validates the registered parameters and probes their dependencies if the probes are enabled.
+// Without custom providers the parameters are parsed from the environment variables and the args.
+//
+func (c *Config) Check(args []string, options ...CheckOption) CheckReport {
+	checkOptions := &checkOptions{
+		timeout: DefaultCheckProbeTimeout,
+		probers: map[string]Prober{
+			TypeRedis:     probeRedis,
+			TypeCassandra: probeCassandra,
+			TypePostgres:  probePostgres,
+			TypeURL:       probeURL,
+		},
+	}
+	for _, option := range options {
+		option(checkOptions)
+	}
+
+	var err error
+	if 0 == len(c.providers) {
+		err = c.ParseFrom(args, getEnvironment())
+	} else {
+		err = c.parse(c.providers)
+	}
+
+	report := CheckReport{Valid: nil == err, Parameters: c.GetParameterStates()}
+	if nil != err {
+		report.Error = c.Redact(err.Error())
+	}
+	if checkOptions.probe {
+		report.Probes = c.probeParameters(report.Parameters, checkOptions)
+	}
+
+	return report
+}
+
+//
+// probeParameters probes the dependencies of the valid non-empty parameters.
+//
+func (c *Config) probeParameters(states []ParameterState, options *checkOptions) []ProbeResult {
+	var results []ProbeResult
+	for _, state := range states {
+		prober, ok := options.probers[state.Type]
+		if !ok || !state.Valid || "" == c.GetValue(Parameter(state.Name)) {
+			continue
+		}
+
+		result := ProbeResult{Parameter: state.Name, Type: state.Type, Available: true}
+		start := time.Now()
+		err := prober(c.getParameterEntry(Parameter(state.Name)), options.timeout)
+		result.Latency = time.Since(start).Seconds()
+		if nil != err {
+			result.Available = false
+			result.Error = c.Redact(err.Error())
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+//
+// isSuccessful returns true if the configuration is valid and all its dependencies are available.
+//
+func (r CheckReport) isSuccessful() bool {
+	if !r.Valid {
+		return false
+	}
+	for _, probe := range r.Probes {
+		if !probe.Available {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// probeRedis connects to the redis server, sentinel or cluster node addresses.
+//
+func probeRedis(parameterEntry ParameterInfoProvider, timeout time.Duration) error {
+	redis, ok := parameterEntry.(RedisConnectionInfoProvider)
+	if !ok {
+		return errors.WithMessage(errors.ErrGetMisregisteredConfigParameter, "kit-cfg@probeRedis")
+	}
+
+	return dialAddresses(redis.GetAddresses(), timeout)
+}
+
+//
+// probeCassandra connects to the cassandra hosts.
+//
+func probeCassandra(parameterEntry ParameterInfoProvider, timeout time.Duration) error {
+	cassandra, ok := parameterEntry.(CassandraConnectionInfoProvider)
+	if !ok {
+		return errors.WithMessage(errors.ErrGetMisregisteredConfigParameter, "kit-cfg@probeCassandra")
+	}
+
+	port := DefaultCassandraPort
+	if 0 != cassandra.GetPort() {
+		port = cassandra.GetPort()
+	}
+	addresses := make([]string, 0, len(cassandra.GetHosts()))
+	for _, host := range cassandra.GetHosts() {
+		addresses = append(addresses, getAddress(host, strconv.Itoa(port)))
+	}
+
+	return dialAddresses(addresses, timeout)
+}
+
+//
+// probePostgres connects to the PostgreSQL host.
+//
+func probePostgres(parameterEntry ParameterInfoProvider, timeout time.Duration) error {
+	postgres, ok := parameterEntry.(PostgresConnectionInfoProvider)
+	if !ok {
+		return errors.WithMessage(errors.ErrGetMisregisteredConfigParameter, "kit-cfg@probePostgres")
+	}
+
+	port := DefaultPostgresPort
+	if "" != postgres.GetPort() {
+		port = postgres.GetPort()
+	}
+
+	return dialAddresses([]string{net.JoinHostPort(postgres.GetHost(), port)}, timeout)
+}
+
+//
+// probeURL connects to the URL host.
+//
+func probeURL(parameterEntry ParameterInfoProvider, timeout time.Duration) error {
+	urlInfo, err := url.Parse(parameterEntry.GetValue())
+	if nil != err {
+		return errors.WithMessage(err, "kit-cfg@probeURL")
+	}
+
+	port := urlInfo.Port()
+	if "" == port {
+		port = "80"
+		if "https" == urlInfo.Scheme {
+			port = "443"
+		}
+	}
+
+	return dialAddresses([]string{net.JoinHostPort(urlInfo.Hostname(), port)}, timeout)
+}
+
+//
+// dialAddresses opens and closes a TCP connection to every address.
+//
+func dialAddresses(addresses []string, timeout time.Duration) error {
+	for _, address := range addresses {
+		connection, err := net.DialTimeout("tcp", address, timeout)
+		if nil != err {
+			return errors.WithMessage(err, `kit-cfg@dialAddresses [address (%s)]`, address)
+		}
+		connection.Close()
+	}
+
+	return nil
+}
+
+//
+// getAddress returns the host:port address, the host port takes precedence over the default one.
+//
+func getAddress(host, defaultPort string) string {
+	if _, _, err := net.SplitHostPort(host); nil == err {
+		return host
+	}
+
+	return net.JoinHostPort(strings.Trim(host, "[]"), defaultPort)
+}
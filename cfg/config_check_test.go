@@ -0,0 +1,106 @@
+package cfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCheck_WithAValidConfiguration_ReportsItAndReturnsZero(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{string(TestParameter): "https://host.com/v1"}))
+	var output bytes.Buffer
+	var report CheckReport
+
+	config.RegisterURLParameter(TestParameter)
+	code := RunCheck(config, nil, &output)
+	err := json.Unmarshal(output.Bytes(), &report)
+
+	assert.Empty(t, err)
+	assert.Equal(t, 0, code)
+	assert.True(t, report.Valid)
+	assert.Empty(t, report.Probes)
+	assert.Equal(t, []ParameterState{
+		{Name: HTTPReadTimeout, Type: TypeDuration, Source: SourceDefault, Value: "1s", Valid: true},
+		{Name: HTTPWriteTimeout, Type: TypeDuration, Source: SourceDefault, Value: "1s", Valid: true},
+		{
+			Name:     string(TestParameter),
+			Type:     TypeURL,
+			Source:   "map",
+			Value:    "https://host.com/v1",
+			Required: true,
+			Valid:    true,
+		},
+	}, report.Parameters)
+}
+
+func TestRunCheck_WithAnInvalidConfiguration_ReportsTheErrorAndReturnsOne(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{}))
+	var output bytes.Buffer
+	var report CheckReport
+
+	config.RegisterURLParameter(TestParameter)
+	code := RunCheck(config, nil, &output)
+	json.Unmarshal(output.Bytes(), &report)
+
+	assert.Equal(t, 1, code)
+	assert.False(t, report.Valid)
+	assert.NotEmpty(t, report.Error)
+	assert.False(t, report.Parameters[2].Valid)
+}
+
+func TestRunCheck_WithTheProbeFlag_ProbesTheParameters(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	config := NewConfig(NewMapProvider("map", map[string]string{string(TestParameter): server.URL}))
+	var output bytes.Buffer
+	var report CheckReport
+
+	config.RegisterURLParameter(TestParameter)
+	code := RunCheck(config, []string{CheckProbeFlag}, &output)
+	json.Unmarshal(output.Bytes(), &report)
+
+	assert.Equal(t, 0, code)
+	assert.Len(t, report.Probes, 1)
+	assert.True(t, report.Probes[0].Available)
+	assert.Equal(t, TypeURL, report.Probes[0].Type)
+}
+
+func TestCheck_WithAnUnavailableDependency_ReportsTheProbeFailure(t *testing.T) {
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	address := listener.Addr().String()
+	listener.Close()
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		string(TestParameter): "redis://:password@" + address,
+	}))
+
+	config.RegisterRedisParameter(TestParameter)
+	report := config.Check(nil, CheckWithProbes(), CheckProbeTimeout(time.Second))
+
+	assert.True(t, report.Valid)
+	assert.Len(t, report.Probes, 1)
+	assert.False(t, report.Probes[0].Available)
+	assert.NotEmpty(t, report.Probes[0].Error)
+	assert.False(t, report.isSuccessful())
+}
+
+func TestCheck_WithACustomProber_UsesIt(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{string(TestParameter): "value"}))
+	var probed string
+	prober := func(p ParameterInfoProvider, _ time.Duration) error {
+		probed = p.GetValue()
+
+		return nil
+	}
+
+	config.RegisterStringParameter(TestParameter)
+	report := config.Check(nil, CheckWithProbes(), CheckProber(TypeString, prober))
+
+	assert.True(t, report.isSuccessful())
+	assert.Equal(t, "value", probed)
+}
@@ -0,0 +1,111 @@
+package cfg
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"gopkg.in/virgil.v4/virgilcrypto"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// EncryptedValuePrefix marks the encrypted parameter values. The encrypted value format is enc:<base64 cipher text>.
+//
+const EncryptedValuePrefix = "enc:"
+
+//
+// EncryptValue encrypts the value for the public key and returns it in the encrypted parameter value format.
+//
+func EncryptValue(value []byte, publicKey virgilcrypto.PublicKey) (string, error) {
+	cipherText, err := virgilcrypto.DefaultCrypto.Encrypt(value, publicKey)
+	if nil != err {
+		return "", errors.WrapError(ErrConfigValueEncryptionError, errors.WithMessage(err, "kit-cfg@EncryptValue"))
+	}
+
+	return EncryptedValuePrefix + base64.StdEncoding.EncodeToString(cipherText), nil
+}
+
+//
+// IsEncryptedValue returns true if the value is in the encrypted parameter value format.
+//
+func IsEncryptedValue(value string) bool {
+
+	return strings.HasPrefix(value, EncryptedValuePrefix)
+}
+
+//
+// decryptParameters decrypts the encrypted parameter values with the ConfigPrivateKey parameter private key.
+// Parameters with the decrypted values are marked secret.
+//
+func (c *Config) decryptParameters(params []Parameter) error {
+	var privateKey virgilcrypto.PrivateKey
+	for _, param := range params {
+		parameterEntry := c.parameters[param]
+		if ConfigPrivateKey == param || !IsEncryptedValue(parameterEntry.GetValue()) {
+			continue
+		}
+
+		if nil == privateKey {
+			var err error
+			if privateKey, err = c.getDecryptionPrivateKey(); nil != err {
+				return errors.WithMessage(err, `kit-cfg@Config.decryptParameters [parameter (%s)]`, param)
+			}
+		}
+
+		value, err := decryptValue(parameterEntry.GetValue(), privateKey)
+		if nil != err {
+			return errors.WithMessage(err, `kit-cfg@Config.decryptParameters [parameter (%s)]`, param)
+		}
+		*parameterEntry.GetValueLink() = value
+		parameterEntry.getStringParameter().secret = true
+	}
+
+	return nil
+}
+
+//
+// getDecryptionPrivateKey returns the private key of the ConfigPrivateKey parameter.
+//
+func (c *Config) getDecryptionPrivateKey() (virgilcrypto.PrivateKey, error) {
+	keyEntry, ok := c.parameters[ConfigPrivateKey].(*Base64StringInfo)
+	if !ok || "" == keyEntry.GetValue() {
+		return nil, errors.WithMessage(ErrConfigDecryptionKeyIsEmpty, `[parameter (%s)]`, ConfigPrivateKey)
+	}
+
+	if err := keyEntry.validate(); nil != err {
+		return nil, errors.WrapError(ErrConfigDecryptionKeyIsIncorrect, err)
+	}
+
+	var password string
+	if passwordEntry, ok := c.parameters[ConfigPrivateKeyPassword]; ok {
+		password = passwordEntry.GetValue()
+	}
+
+	privateKey, err := virgilcrypto.DecodePrivateKey(keyEntry.GetDecodedValue(), []byte(password))
+	if nil != err {
+		return nil, errors.WrapError(
+			ErrConfigDecryptionKeyIsIncorrect,
+			errors.WithMessage(err, `kit-cfg@Config.getDecryptionPrivateKey [parameter (%s)]`, ConfigPrivateKey),
+		)
+	}
+
+	return privateKey, nil
+}
+
+//
+// decryptValue decrypts the value in the encrypted parameter value format.
+//
+func decryptValue(value string, privateKey virgilcrypto.PrivateKey) (string, error) {
+	cipherText, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, EncryptedValuePrefix))
+	if nil != err {
+		return "", errors.WrapError(ErrConfigValueDecryptionError, errors.WithMessage(err, "kit-cfg@decryptValue"))
+	}
+
+	plainText, err := virgilcrypto.DefaultCrypto.Decrypt(cipherText, privateKey)
+	if nil != err {
+		return "", errors.WrapError(ErrConfigValueDecryptionError, errors.WithMessage(err, "kit-cfg@decryptValue"))
+	}
+
+	return string(plainText), nil
+}
@@ -0,0 +1,56 @@
+package cfg
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestParse_WithAnEncryptedValue_DecryptsIt(t *testing.T) {
+	privateKey, publicKey := helper.GenerateKeys()
+	encodedPrivateKey, _ := privateKey.Encode(nil)
+	encryptedValue, errEncrypting := EncryptValue([]byte("https://host.com/v1"), publicKey)
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		ConfigPrivateKey:      base64.StdEncoding.EncodeToString(encodedPrivateKey),
+		string(TestParameter): encryptedValue,
+	}))
+
+	config.Register(ConfigPrivateKey)
+	config.RegisterURLParameter(TestParameter)
+	errParsing := config.Parse()
+	url, _ := config.GetURLParameter(TestParameter)
+
+	assert.Empty(t, errEncrypting)
+	assert.Empty(t, errParsing)
+	assert.True(t, IsEncryptedValue(encryptedValue))
+	assert.Equal(t, "https://host.com/v1", url.GetValue())
+	assert.Equal(t, "host.com", url.GetHost())
+	assert.True(t, url.IsSecret())
+}
+
+func TestParse_WithAnEncryptedValueAndWithoutAPrivateKey_ReturnsAnError(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{string(TestParameter): EncryptedValuePrefix + "ZGF0YQ=="}))
+
+	config.RegisterStringParameter(TestParameter)
+	err := config.Parse()
+
+	helper.AssertError(t, ErrConfigDecryptionKeyIsEmpty, err)
+}
+
+func TestParse_WithAMalformedEncryptedValue_ReturnsAnError(t *testing.T) {
+	privateKey, _ := helper.GenerateKeys()
+	encodedPrivateKey, _ := privateKey.Encode(nil)
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		ConfigPrivateKey:      base64.StdEncoding.EncodeToString(encodedPrivateKey),
+		string(TestParameter): EncryptedValuePrefix + "not a base64 value",
+	}))
+
+	config.Register(ConfigPrivateKey)
+	config.RegisterStringParameter(TestParameter)
+	err := config.Parse()
+
+	helper.AssertError(t, ErrConfigValueDecryptionError, err)
+}
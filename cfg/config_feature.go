@@ -0,0 +1,208 @@
+package cfg
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Feature is a feature flag name.
+//
+type Feature string
+
+//
+// Feature flag constants.
+//
+const (
+	FeatureParameterPrefix = "FEATURE_"
+	FeaturePercentageSign  = "%"
+)
+
+//
+// FeatureInfoProvider declares the feature flag getters.
+//
+type FeatureInfoProvider interface {
+	GetPercentage() int
+	IsEnabled() bool
+	IsEnabledFor(key string) bool
+
+	ParameterInfoProvider
+}
+
+//
+// FeatureInfo is a feature flag configuration parameter.
+// Its value is a boolean (see BoolInfo) or a rollout percentage like 25%. A percentage flag is enabled for the
+// same share of the keys, and a key always gets the same result for the same percentage.
+//
+type FeatureInfo struct {
+	feature    Feature
+	percentage int
+
+	*StringParameter
+}
+
+//
+// FeatureState describes the current state of a feature flag.
+//
+type FeatureState struct {
+	Name       string `json:"name"`
+	Parameter  string `json:"parameter"`
+	Value      string `json:"value"`
+	Percentage int    `json:"percentage"`
+	Source     string `json:"source,omitempty"`
+}
+
+//
+// RegisterFeature registers the feature flag with the default value. The flag value is set by the FEATURE_<NAME>
+// parameter, e.g. the FEATURE_NEW_SEARCH parameter for the "new_search" feature.
+//
+func (c *Config) RegisterFeature(feature Feature, defaultValue string, options ...ParameterOption) {
+	c.register(newFeatureParameter(feature), append([]ParameterOption{Default(defaultValue)}, options...))
+}
+
+//
+// GetFeatureParameter returns the feature flag info.
+//
+func (c *Config) GetFeatureParameter(feature Feature) (FeatureInfoProvider, error) {
+	flag, ok := c.getParameterEntry(feature.getParameter()).(FeatureInfoProvider)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			`kit-cfg@Config.GetFeatureParameter [feature (%s)]`, feature,
+		)
+	}
+
+	return flag, nil
+}
+
+//
+// IsFeatureEnabled returns true if the feature flag is enabled for everyone.
+// Not registered features are disabled.
+//
+func (c *Config) IsFeatureEnabled(feature Feature) bool {
+	flag, err := c.GetFeatureParameter(feature)
+
+	return nil == err && flag.IsEnabled()
+}
+
+//
+// IsFeatureEnabledFor returns true if the feature flag is enabled for the key, like an application ID.
+// Not registered features are disabled.
+//
+func (c *Config) IsFeatureEnabledFor(feature Feature, key string) bool {
+	flag, err := c.GetFeatureParameter(feature)
+
+	return nil == err && flag.IsEnabledFor(key)
+}
+
+//
+// GetFeatureStates returns the states of all registered feature flags ordered by the parameter name.
+//
+func (c *Config) GetFeatureStates() []FeatureState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var states []FeatureState
+	for _, param := range c.getParameterNames() {
+		flag, ok := c.parameters[param].(*FeatureInfo)
+		if !ok {
+			continue
+		}
+		states = append(states, FeatureState{
+			Name:       string(flag.feature),
+			Parameter:  flag.GetName(),
+			Value:      flag.GetRedactedValue(),
+			Percentage: flag.GetPercentage(),
+			Source:     c.sources[param],
+		})
+	}
+
+	return states
+}
+
+//
+// GetPercentage returns the rollout percentage, 100 for the enabled and 0 for the disabled flag.
+//
+func (p *FeatureInfo) GetPercentage() int {
+
+	return p.percentage
+}
+
+//
+// IsEnabled returns true if the flag is enabled for everyone.
+//
+func (p *FeatureInfo) IsEnabled() bool {
+
+	return 100 == p.percentage
+}
+
+//
+// IsEnabledFor returns true if the key falls into the rollout percentage.
+//
+func (p *FeatureInfo) IsEnabledFor(key string) bool {
+	hash := fnv.New32a()
+	hash.Write([]byte(string(p.feature) + ":" + key))
+
+	return int(hash.Sum32()%100) < p.percentage
+}
+
+//
+// validate validates the parameter value to be a boolean or a percentage.
+//
+func (p *FeatureInfo) validate() error {
+	var err error
+	if err = p.StringParameter.validate(); nil != err {
+		return err
+	}
+
+	value := p.GetValue()
+	errMsg := `kit-cfg@FeatureInfo.validate [parameter (%s), value (%s)]`
+	if !strings.HasSuffix(value, FeaturePercentageSign) {
+		enabled, err := strconv.ParseBool(value)
+		if nil != err {
+			return errors.WrapError(
+				ErrFeatureValueIsIncorrect,
+				errors.WithMessage(err, errMsg, p.GetName(), p.GetRedactedValue()),
+			)
+		}
+		p.percentage = 0
+		if enabled {
+			p.percentage = 100
+		}
+
+		return nil
+	}
+
+	percentage, err := strconv.Atoi(strings.TrimSuffix(value, FeaturePercentageSign))
+	if nil == err && (percentage < 0 || 100 < percentage) {
+		err = errors.New("percentage must be in the range [0, 100]")
+	}
+	if nil != err {
+		return errors.WrapError(
+			ErrFeatureValueIsIncorrect,
+			errors.WithMessage(err, errMsg, p.GetName(), p.GetRedactedValue()),
+		)
+	}
+	p.percentage = percentage
+
+	return nil
+}
+
+//
+// getParameter returns the feature flag parameter name.
+//
+func (f Feature) getParameter() Parameter {
+
+	return Parameter(FeatureParameterPrefix + strings.ToUpper(string(f)))
+}
+
+//
+// newFeatureParameter returns a new instance of the feature flag parameter.
+//
+func newFeatureParameter(feature Feature) *FeatureInfo {
+
+	return &FeatureInfo{feature: feature, StringParameter: newStringParameter(feature.getParameter())}
+}
@@ -0,0 +1,90 @@
+package cfg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+const (
+	FeatureTestName Feature = "new_search"
+)
+
+func TestRegisterFeature_WithoutAValue_UsesTheDefault(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{}))
+
+	config.RegisterFeature(FeatureTestName, "true")
+	err := config.Parse()
+
+	assert.Empty(t, err)
+	assert.True(t, config.IsFeatureEnabled(FeatureTestName))
+	assert.True(t, config.IsFeatureEnabledFor(FeatureTestName, "application-id"))
+}
+
+func TestRegisterFeature_WithAFalseValue_DisablesTheFeature(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"FEATURE_NEW_SEARCH": "false"}))
+
+	config.RegisterFeature(FeatureTestName, "true")
+	err := config.Parse()
+
+	assert.Empty(t, err)
+	assert.False(t, config.IsFeatureEnabled(FeatureTestName))
+	assert.False(t, config.IsFeatureEnabledFor(FeatureTestName, "application-id"))
+}
+
+func TestRegisterFeature_WithAPercentage_EnablesTheFeatureForAShareOfKeys(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"FEATURE_NEW_SEARCH": "30%"}))
+	enabled := 0
+
+	config.RegisterFeature(FeatureTestName, "false")
+	err := config.Parse()
+	for i := 0; i < 1000; i++ {
+		if config.IsFeatureEnabledFor(FeatureTestName, fmt.Sprintf("application-%d", i)) {
+			enabled++
+		}
+	}
+
+	assert.Empty(t, err)
+	assert.False(t, config.IsFeatureEnabled(FeatureTestName))
+	assert.InDelta(t, 300, enabled, 60)
+	assert.Equal(
+		t,
+		config.IsFeatureEnabledFor(FeatureTestName, "application-id"),
+		config.IsFeatureEnabledFor(FeatureTestName, "application-id"),
+	)
+}
+
+func TestRegisterFeature_WithAnIncorrectValue_ReturnsAnError(t *testing.T) {
+	for _, value := range []string{"yes", "101%", "-1%", "a%"} {
+		config := NewConfig(NewMapProvider("map", map[string]string{"FEATURE_NEW_SEARCH": value}))
+
+		config.RegisterFeature(FeatureTestName, "false")
+		err := config.Parse()
+
+		helper.AssertError(t, ErrFeatureValueIsIncorrect, err)
+	}
+}
+
+func TestIsFeatureEnabled_WithoutRegistration_ReturnsFalse(t *testing.T) {
+	config := NewConfig()
+
+	assert.False(t, config.IsFeatureEnabled(FeatureTestName))
+	assert.False(t, config.IsFeatureEnabledFor(FeatureTestName, "application-id"))
+}
+
+func TestGetFeatureStates_WithRegisteredFeatures_DescribesThem(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"FEATURE_NEW_SEARCH": "25%"}))
+
+	config.RegisterFeature(FeatureTestName, "false")
+	config.RegisterFeature("dark_mode", "true")
+	config.RegisterStringParameter(TestParameter, Optional())
+	config.Parse()
+
+	assert.Equal(t, []FeatureState{
+		{Name: "dark_mode", Parameter: "FEATURE_DARK_MODE", Value: "true", Percentage: 100, Source: SourceDefault},
+		{Name: "new_search", Parameter: "FEATURE_NEW_SEARCH", Value: "25%", Percentage: 25, Source: "map"},
+	}, config.GetFeatureStates())
+}
@@ -0,0 +1,270 @@
+package cfg
+
+import (
+	"sort"
+	"strings"
+)
+
+//
+// ParameterGroup is a set of parameters registered under a name prefix, e.g. SESSION_ or CACHE_, so the same set of
+// parameters can be registered for several instances of a resource, like two redis servers.
+//
+type ParameterGroup struct {
+	config *Config
+	prefix string
+}
+
+//
+// Group returns the parameter group with the prefix.
+//
+func (c *Config) Group(prefix string) *ParameterGroup {
+
+	return &ParameterGroup{config: c, prefix: prefix}
+}
+
+//
+// GetPrefix returns the group prefix.
+//
+func (g *ParameterGroup) GetPrefix() string {
+
+	return g.prefix
+}
+
+//
+// GetDependencyName returns the name of the group dependency for the DIContainer, e.g. session_redis for the redis
+// dependency of the SESSION_ group.
+//
+func (g *ParameterGroup) GetDependencyName(name string) string {
+
+	return strings.ToLower(g.prefix) + name
+}
+
+//
+// Param returns the prefixed parameter name to be used with the Config methods.
+//
+func (g *ParameterGroup) Param(param Parameter) Parameter {
+
+	return Parameter(g.prefix) + param
+}
+
+//
+// Bind registers the prefixed parameters for the tagged fields of the target struct, see Config.Bind.
+// The same struct type can be bound for every group to retrieve the group parameters as a unit.
+//
+func (g *ParameterGroup) Bind(target interface{}) error {
+
+	return g.config.bind(target, g.prefix)
+}
+
+//
+// GetParameters returns the parameters registered with the group by their names without the prefix.
+// The parameters registered with the Config methods or with the groups of other prefixes are not returned even if
+// their names start with the group prefix.
+//
+func (g *ParameterGroup) GetParameters() map[Parameter]ParameterInfoProvider {
+	g.config.mu.RLock()
+	defer g.config.mu.RUnlock()
+
+	parameters := make(map[Parameter]ParameterInfoProvider)
+	for param := range g.config.groups[g.prefix] {
+		parameters[Parameter(strings.TrimPrefix(string(param), g.prefix))] = g.config.parameters[param]
+	}
+
+	return parameters
+}
+
+//
+// GetParameterNames returns the registered group parameter names without the prefix in the alphabetical order.
+//
+func (g *ParameterGroup) GetParameterNames() []Parameter {
+	var params []Parameter
+	for param := range g.GetParameters() {
+		params = append(params, param)
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i] < params[j] })
+
+	return params
+}
+
+//
+// GetValue returns the group parameter value.
+//
+func (g *ParameterGroup) GetValue(param Parameter) string {
+
+	return g.config.GetValue(g.Param(param))
+}
+
+//
+// RegisterStringParameter registers a string group parameter.
+//
+func (g *ParameterGroup) RegisterStringParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterStringParameter, param, options)
+}
+
+//
+// GetStringParameter returns a string group parameter info.
+//
+func (g *ParameterGroup) GetStringParameter(param Parameter) (ParameterInfoProvider, error) {
+
+	return g.config.GetStringParameter(g.Param(param))
+}
+
+//
+// RegisterRedisParameter registers a redis connection group parameter.
+//
+func (g *ParameterGroup) RegisterRedisParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterRedisParameter, param, options)
+}
+
+//
+// GetRedisParameter returns a redis connection group parameter info.
+//
+func (g *ParameterGroup) GetRedisParameter(param Parameter) (RedisConnectionInfoProvider, error) {
+
+	return g.config.GetRedisParameter(g.Param(param))
+}
+
+//
+// RegisterCassandraParameter registers a cassandra connection group parameter.
+//
+func (g *ParameterGroup) RegisterCassandraParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterCassandraParameter, param, options)
+}
+
+//
+// GetCassandraParameter returns a cassandra connection group parameter info.
+//
+func (g *ParameterGroup) GetCassandraParameter(param Parameter) (CassandraConnectionInfoProvider, error) {
+
+	return g.config.GetCassandraParameter(g.Param(param))
+}
+
+//
+// RegisterPostgresParameter registers a PostgreSQL connection group parameter.
+//
+func (g *ParameterGroup) RegisterPostgresParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterPostgresParameter, param, options)
+}
+
+//
+// GetPostgresParameter returns a PostgreSQL connection group parameter info.
+//
+func (g *ParameterGroup) GetPostgresParameter(param Parameter) (PostgresConnectionInfoProvider, error) {
+
+	return g.config.GetPostgresParameter(g.Param(param))
+}
+
+//
+// RegisterURLParameter registers a URL group parameter.
+//
+func (g *ParameterGroup) RegisterURLParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterURLParameter, param, options)
+}
+
+//
+// GetURLParameter returns a URL group parameter info.
+//
+func (g *ParameterGroup) GetURLParameter(param Parameter) (URLInfoProvider, error) {
+
+	return g.config.GetURLParameter(g.Param(param))
+}
+
+//
+// RegisterIntParameter registers an integer group parameter.
+//
+func (g *ParameterGroup) RegisterIntParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterIntParameter, param, options)
+}
+
+//
+// GetIntParameter returns an integer group parameter info.
+//
+func (g *ParameterGroup) GetIntParameter(param Parameter) (IntInfoProvider, error) {
+
+	return g.config.GetIntParameter(g.Param(param))
+}
+
+//
+// RegisterBoolParameter registers a boolean group parameter.
+//
+func (g *ParameterGroup) RegisterBoolParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterBoolParameter, param, options)
+}
+
+//
+// GetBoolParameter returns a boolean group parameter info.
+//
+func (g *ParameterGroup) GetBoolParameter(param Parameter) (BoolInfoProvider, error) {
+
+	return g.config.GetBoolParameter(g.Param(param))
+}
+
+//
+// RegisterDurationParameter registers a duration group parameter.
+//
+func (g *ParameterGroup) RegisterDurationParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterDurationParameter, param, options)
+}
+
+//
+// GetDurationParameter returns a duration group parameter info.
+//
+func (g *ParameterGroup) GetDurationParameter(param Parameter) (DurationInfoProvider, error) {
+
+	return g.config.GetDurationParameter(g.Param(param))
+}
+
+//
+// RegisterListParameter registers a list group parameter.
+//
+func (g *ParameterGroup) RegisterListParameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterListParameter, param, options)
+}
+
+//
+// GetListParameter returns a list group parameter info.
+//
+func (g *ParameterGroup) GetListParameter(param Parameter) (ListInfoProvider, error) {
+
+	return g.config.GetListParameter(g.Param(param))
+}
+
+//
+// RegisterBase64Parameter registers a base64-encoded group parameter.
+//
+func (g *ParameterGroup) RegisterBase64Parameter(param Parameter, options ...ParameterOption) {
+	g.register(g.config.RegisterBase64Parameter, param, options)
+}
+
+//
+// GetBase64Parameter returns a base64-encoded group parameter info.
+//
+func (g *ParameterGroup) GetBase64Parameter(param Parameter) (Base64StringInfoProvider, error) {
+
+	return g.config.GetBase64Parameter(g.Param(param))
+}
+
+//
+// register registers the prefixed parameter with the registration method and adds it to the group.
+//
+func (g *ParameterGroup) register(
+	registerParameter func(Parameter, ...ParameterOption),
+	param Parameter,
+	options []ParameterOption,
+) {
+	registerParameter(g.Param(param), options...)
+	g.config.addGroupParameter(g.prefix, g.Param(param))
+}
+
+//
+// addGroupParameter adds the registered parameter to the group of the prefix.
+//
+func (c *Config) addGroupParameter(prefix string, param Parameter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if nil == c.groups[prefix] {
+		c.groups[prefix] = make(map[Parameter]bool)
+	}
+	c.groups[prefix][param] = true
+}
@@ -0,0 +1,112 @@
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sarulabs/di"
+	"github.com/stretchr/testify/assert"
+)
+
+type redisGroupTest struct {
+	Connection *RedisConnectionInfo `cfg:"REDIS_URL"`
+	Timeout    time.Duration        `cfg:"REDIS_TIMEOUT,default=1s"`
+}
+
+func TestGroup_WithTwoBoundGroups_RegistersThePrefixedParameters(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		"SESSION_REDIS_URL":     "redis://session.host.com:6379/1",
+		"CACHE_REDIS_URL":       "redis://cache.host.com:6379/2",
+		"CACHE_REDIS_TIMEOUT":   "5s",
+		"UNRELATED_REDIS_URL":   "redis://unrelated.host.com:6379",
+		"UNRELATED_REDIS_TOKEN": "token",
+	}))
+	var session, cache redisGroupTest
+
+	errSession := config.Group("SESSION_").Bind(&session)
+	errCache := config.Group("CACHE_").Bind(&cache)
+	errParsing := config.Parse()
+
+	assert.Empty(t, errSession)
+	assert.Empty(t, errCache)
+	assert.Empty(t, errParsing)
+	assert.Equal(t, "session.host.com", session.Connection.GetHost())
+	assert.Equal(t, 1, session.Connection.GetDB())
+	assert.Equal(t, time.Second, session.Timeout)
+	assert.Equal(t, "cache.host.com", cache.Connection.GetHost())
+	assert.Equal(t, time.Second*5, cache.Timeout)
+	assert.Equal(t, []Parameter{"REDIS_TIMEOUT", "REDIS_URL"}, config.Group("CACHE_").GetParameterNames())
+}
+
+func TestGroup_WithRegisteredParameters_ReturnsThemByTheUnprefixedNames(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"SESSION_REDIS_URL": "redis://session.host.com:6379"}))
+	group := config.Group("SESSION_")
+
+	group.RegisterRedisParameter("REDIS_URL")
+	err := config.Parse()
+	redis, errGetting := group.GetRedisParameter("REDIS_URL")
+
+	assert.Empty(t, err)
+	assert.Empty(t, errGetting)
+	assert.Equal(t, "session.host.com", redis.GetHost())
+	assert.Equal(t, "redis://session.host.com:6379", group.GetValue("REDIS_URL"))
+	assert.Equal(t, config.GetValue("SESSION_REDIS_URL"), group.GetValue("REDIS_URL"))
+	assert.Equal(t, Parameter("SESSION_REDIS_URL"), group.Param("REDIS_URL"))
+}
+
+func TestGroup_WithNestedAndUngroupedParameters_ReturnsOnlyTheGroupParameters(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		"CACHE_REDIS_URL":         "redis://cache.host.com:6379",
+		"CACHE_TTL":               "1m",
+		"CACHE_SIZE":              "100",
+		"CACHE_SESSION_REDIS_URL": "redis://session.host.com:6379",
+		"CACHE_STANDALONE":        "value",
+	}))
+	cache, session := config.Group("CACHE_"), config.Group("CACHE_SESSION_")
+
+	cache.RegisterRedisParameter("REDIS_URL")
+	cache.RegisterDurationParameter("TTL")
+	cache.RegisterIntParameter("SIZE")
+	session.RegisterRedisParameter("REDIS_URL")
+	config.RegisterStringParameter("CACHE_STANDALONE")
+	err := config.Parse()
+	ttl, errTTL := cache.GetDurationParameter("TTL")
+	size, errSize := cache.GetIntParameter("SIZE")
+
+	assert.Empty(t, err)
+	assert.Empty(t, errTTL)
+	assert.Empty(t, errSize)
+	assert.Equal(t, time.Minute, ttl.GetDuration())
+	assert.Equal(t, 100, size.GetInt())
+	assert.Equal(t, []Parameter{"REDIS_URL", "SIZE", "TTL"}, cache.GetParameterNames())
+	assert.Equal(t, []Parameter{"REDIS_URL"}, session.GetParameterNames())
+}
+
+func TestRegisterGroupDependency_WithTwoGroups_RegistersThePrefixedDependencies(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		"SESSION_REDIS_URL": "redis://session.host.com:6379",
+		"CACHE_REDIS_URL":   "redis://cache.host.com:6379",
+	}))
+	container := NewDIContainer()
+	registrar := func(ctx di.Context, group *ParameterGroup) (interface{}, error) {
+		redis, err := group.GetRedisParameter("REDIS_URL")
+		if nil != err {
+			return nil, err
+		}
+
+		return redis.GetHost(), nil
+	}
+
+	session, cache := config.Group("SESSION_"), config.Group("CACHE_")
+	session.RegisterRedisParameter("REDIS_URL")
+	cache.RegisterRedisParameter("REDIS_URL")
+	config.Parse()
+	errSession := container.RegisterGroupDependency(session, "redis", registrar, nil)
+	errCache := container.RegisterGroupDependency(cache, "redis", registrar, nil)
+	container.Build()
+
+	assert.Empty(t, errSession)
+	assert.Empty(t, errCache)
+	assert.Equal(t, "session.host.com", container.Get("session_redis"))
+	assert.Equal(t, "cache.host.com", container.Get("cache_redis"))
+}
@@ -46,9 +46,9 @@ func (p *Base64StringInfo) validate() error {
 		return errors.WrapError(
 			errors.WithMessage(
 				err,
-				`kit-cfg@Base64StringInfo.validate [parameter (%s), value ($s)]`,
-				p.GetValue(),
+				`kit-cfg@Base64StringInfo.validate [parameter (%s), value (%s)]`,
 				p.GetName(),
+				p.GetRedactedValue(),
 			),
 			ErrBase64IncorrectValue,
 		)
@@ -0,0 +1,64 @@
+package cfg
+
+import (
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// BoolInfoProvider declares the boolean parameter getters.
+//
+type BoolInfoProvider interface {
+	GetBool() bool
+
+	ParameterInfoProvider
+}
+
+//
+// BoolInfo is a boolean configuration parameter.
+// It accepts 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False values.
+//
+type BoolInfo struct {
+	boolValue bool
+
+	*StringParameter
+}
+
+//
+// newBoolInfo returns a new boolean parameter info object instance.
+//
+func newBoolInfo() *BoolInfo {
+	return &BoolInfo{
+		StringParameter: &StringParameter{},
+	}
+}
+
+//
+// GetBool returns the parsed parameter value.
+//
+func (p *BoolInfo) GetBool() bool {
+
+	return p.boolValue
+}
+
+//
+// validate validates the parameter value to be a boolean.
+//
+func (p *BoolInfo) validate() error {
+	var err error
+	if err = p.StringParameter.validate(); nil != err {
+		return err
+	}
+
+	if p.boolValue, err = parseBool(p.GetValue()); nil != err {
+		return errors.WrapError(
+			ErrConfigParameterIsNotABoolean,
+			errors.WithMessage(
+				err,
+				`kit-cfg@BoolInfo.validate [parameter (%s), value (%s)]`,
+				p.GetName(), p.GetRedactedValue(),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package cfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestBoolValidate_WithANotBooleanValue_ReturnsAnError(t *testing.T) {
+	bi := newBoolInfo()
+	bi.value = "yes"
+
+	err := bi.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsNotABoolean, err)
+}
+
+func TestBoolValidate_WithACorrectValue_Passes(t *testing.T) {
+	bi := newBoolInfo()
+	bi.value = "true"
+
+	err := bi.validate()
+
+	assert.Empty(t, err)
+	assert.True(t, bi.GetBool())
+}
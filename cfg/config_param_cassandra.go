@@ -2,7 +2,9 @@ package cfg
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"fmt"
 	"github.com/ameteiko/golang-kit/errors"
@@ -15,8 +17,41 @@ const (
 	CassandraConnectionProtocol = "cassandra"
 )
 
+//
+// Cassandra connection URL query options.
+//
+const (
+	CassandraOptionDataCenter      = "dc"
+	CassandraOptionPort            = "port"
+	CassandraOptionConnectTimeout  = "connect_timeout"
+	CassandraOptionTimeout         = "timeout"
+	CassandraOptionConsistency     = "consistency"
+	CassandraOptionProtocolVersion = "protocol_version"
+	CassandraOptionCompression     = "compression"
+	CassandraOptionNumConns        = "num_conns"
+	CassandraOptionTLS             = "tls"
+	CassandraOptionTLSCAFile       = "tls_ca_file"
+	CassandraOptionTLSCertFile     = "tls_cert_file"
+	CassandraOptionTLSKeyFile      = "tls_key_file"
+)
+
+//
+// CassandraCompressionSnappy is the only supported Cassandra compression.
+//
+const CassandraCompressionSnappy = "snappy"
+
+//
+// cassandraConsistencyLevels lists the supported Cassandra consistency levels.
+//
+var cassandraConsistencyLevels = []string{
+	"ANY", "ONE", "TWO", "THREE", "QUORUM", "ALL", "LOCAL_QUORUM", "EACH_QUORUM", "LOCAL_ONE",
+}
+
 //
 // CassandraConnectionInfo is a cassandra database connection info parameter.
+// The connection string query accepts only the Cassandra connection options, the validation rejects any other query
+// key with ErrCassandraOptionIsUnknown. The connection strings with the keys ignored before, like cassandraDc, must
+// be updated, the data center is set with the dc option.
 //
 type CassandraConnectionInfo struct {
 	hosts      []string
@@ -24,10 +59,28 @@ type CassandraConnectionInfo struct {
 	dataCenter string
 	user       string
 	password   string
+	options    cassandraOptions
 
 	*StringParameter
 }
 
+//
+// cassandraOptions are the Cassandra connection URL query options.
+//
+type cassandraOptions struct {
+	port            int
+	connectTimeout  time.Duration
+	timeout         time.Duration
+	consistency     string
+	protocolVersion int
+	compression     string
+	numConns        int
+	tls             bool
+	tlsCAFile       string
+	tlsCertFile     string
+	tlsKeyFile      string
+}
+
 //
 // CassandraConnectionInfoProvider declares all the connection info getters.
 //
@@ -37,9 +90,20 @@ type CassandraConnectionInfoProvider interface {
 	GetDataCenter() string
 	GetUser() string
 	GetPassword() string
+	GetPort() int
+	GetConnectTimeout() time.Duration
+	GetTimeout() time.Duration
+	GetConsistency() string
+	GetProtocolVersion() int
+	GetCompression() string
+	GetNumConns() int
+	GetTLSCAFile() string
+	GetTLSCertFile() string
+	GetTLSKeyFile() string
 
 	IsAuthorizationRequired() bool
 	IsDCAware() bool
+	IsTLS() bool
 
 	ParameterInfoProvider
 }
@@ -93,6 +157,95 @@ func (c *CassandraConnectionInfo) GetPassword() string {
 	return c.password
 }
 
+//
+// GetPort returns the port or zero if it is not set.
+//
+func (c *CassandraConnectionInfo) GetPort() int {
+
+	return c.options.port
+}
+
+//
+// GetConnectTimeout returns the initial connection timeout or zero if it is not set.
+//
+func (c *CassandraConnectionInfo) GetConnectTimeout() time.Duration {
+
+	return c.options.connectTimeout
+}
+
+//
+// GetTimeout returns the query timeout or zero if it is not set.
+//
+func (c *CassandraConnectionInfo) GetTimeout() time.Duration {
+
+	return c.options.timeout
+}
+
+//
+// GetConsistency returns the upper-cased consistency level or an empty string if it is not set.
+//
+func (c *CassandraConnectionInfo) GetConsistency() string {
+
+	return c.options.consistency
+}
+
+//
+// GetProtocolVersion returns the native protocol version or zero if it is not set.
+//
+func (c *CassandraConnectionInfo) GetProtocolVersion() int {
+
+	return c.options.protocolVersion
+}
+
+//
+// GetCompression returns the compression algorithm or an empty string if it is not set.
+//
+func (c *CassandraConnectionInfo) GetCompression() string {
+
+	return c.options.compression
+}
+
+//
+// GetNumConns returns the number of connections per host or zero if it is not set.
+//
+func (c *CassandraConnectionInfo) GetNumConns() int {
+
+	return c.options.numConns
+}
+
+//
+// GetTLSCAFile returns the CA certificate file path.
+//
+func (c *CassandraConnectionInfo) GetTLSCAFile() string {
+
+	return c.options.tlsCAFile
+}
+
+//
+// GetTLSCertFile returns the client certificate file path.
+//
+func (c *CassandraConnectionInfo) GetTLSCertFile() string {
+
+	return c.options.tlsCertFile
+}
+
+//
+// GetTLSKeyFile returns the client key file path.
+//
+func (c *CassandraConnectionInfo) GetTLSKeyFile() string {
+
+	return c.options.tlsKeyFile
+}
+
+//
+// IsTLS returns true if the connection is secured with TLS.
+// TLS is enabled with the tls option or any of the TLS file options, the file options are rejected with tls=false.
+//
+func (c *CassandraConnectionInfo) IsTLS() bool {
+
+	return c.options.tls || "" != c.options.tlsCAFile || "" != c.options.tlsCertFile
+}
+
 //
 // IsDCAware returns true if data center info was set.
 //
@@ -117,13 +270,13 @@ func (c *CassandraConnectionInfo) validate() error {
 	var connectionInfo *url.URL
 
 	connectionString := c.GetValue()
-	errMsg := fmt.Sprintf(`kit-cfg@CassandraConnectionInfo.validate [connection string (%s)]`, connectionString)
+	errMsg := fmt.Sprintf(`kit-cfg@CassandraConnectionInfo.validate [connection string (%s)]`, c.GetRedactedValue())
 
 	if "" == connectionString {
 		return ErrCassandraConnectionStringIsEmpty
 	}
 
-	if connectionInfo, err = url.Parse(connectionString); nil != err {
+	if connectionInfo, err = parseURL(connectionString); nil != err {
 		return errors.WrapError(ErrCassandraConnectionStringIsIncorrect, errors.WithMessage(err, errMsg))
 	}
 
@@ -145,6 +298,10 @@ func (c *CassandraConnectionInfo) validate() error {
 
 	c.dataCenter = validateCassandraDCClause(connectionInfo)
 
+	if c.options, err = validateCassandraOptionsClause(connectionInfo); nil != err {
+		return errors.WithMessage(err, "%s", errMsg)
+	}
+
 	return nil
 }
 
@@ -198,3 +355,99 @@ func validateCassandraDCClause(url *url.URL) string {
 
 	return ""
 }
+
+//
+// validateCassandraOptionsClause validates the query options clause.
+// Unknown options, including the legacy keys ignored before, are rejected, the dc option is validated by
+// validateCassandraDCClause.
+//
+func validateCassandraOptionsClause(url *url.URL) (cassandraOptions, error) {
+	var err error
+	options := cassandraOptions{}
+	isTLSDisabled := false
+	for option, values := range url.Query() {
+		value := values[len(values)-1]
+		switch option {
+		case CassandraOptionPort:
+			options.port, err = parseCassandraPositiveIntOption(value)
+		case CassandraOptionConnectTimeout:
+			options.connectTimeout, err = parseCassandraTimeoutOption(value)
+		case CassandraOptionTimeout:
+			options.timeout, err = parseCassandraTimeoutOption(value)
+		case CassandraOptionConsistency:
+			options.consistency = strings.ToUpper(value)
+			if !isValueAllowed(options.consistency, cassandraConsistencyLevels) {
+				err = errors.New("consistency level is not supported")
+			}
+		case CassandraOptionProtocolVersion:
+			options.protocolVersion, err = parseCassandraPositiveIntOption(value)
+		case CassandraOptionCompression:
+			options.compression = strings.ToLower(value)
+			if CassandraCompressionSnappy != options.compression {
+				err = errors.New("compression is not supported")
+			}
+		case CassandraOptionNumConns:
+			options.numConns, err = parseCassandraPositiveIntOption(value)
+		case CassandraOptionTLS:
+			options.tls, err = strconv.ParseBool(value)
+			isTLSDisabled = nil == err && !options.tls
+		case CassandraOptionTLSCAFile:
+			options.tlsCAFile = value
+		case CassandraOptionTLSCertFile:
+			options.tlsCertFile = value
+		case CassandraOptionTLSKeyFile:
+			options.tlsKeyFile = value
+		case CassandraOptionDataCenter:
+		default:
+			return options, errors.WithMessage(ErrCassandraOptionIsUnknown, `[option (%s)]`, option)
+		}
+
+		if nil != err {
+			return options, errors.WrapError(
+				ErrCassandraOptionIsIncorrect,
+				errors.WithMessage(err, `[option (%s)]`, option),
+			)
+		}
+	}
+
+	if ("" == options.tlsCertFile) != ("" == options.tlsKeyFile) {
+		return options, errors.WithMessage(
+			ErrCassandraOptionIsIncorrect,
+			`[options (%s, %s)] must be set together`,
+			CassandraOptionTLSCertFile, CassandraOptionTLSKeyFile,
+		)
+	}
+	if isTLSDisabled && ("" != options.tlsCAFile || "" != options.tlsCertFile) {
+		return options, errors.WithMessage(
+			ErrCassandraOptionIsIncorrect,
+			`[options (%s, %s)] must not be set with (%s=false)`,
+			CassandraOptionTLSCAFile, CassandraOptionTLSCertFile, CassandraOptionTLS,
+		)
+	}
+
+	return options, nil
+}
+
+//
+// parseCassandraPositiveIntOption parses the positive integer option value.
+//
+func parseCassandraPositiveIntOption(value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if nil == err && number <= 0 {
+		return 0, errors.New("value must be positive")
+	}
+
+	return number, err
+}
+
+//
+// parseCassandraTimeoutOption parses the positive timeout option value.
+//
+func parseCassandraTimeoutOption(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if nil == err && timeout <= 0 {
+		return 0, errors.New("timeout must be positive")
+	}
+
+	return timeout, err
+}
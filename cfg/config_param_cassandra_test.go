@@ -3,6 +3,7 @@ package cfg
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -95,7 +96,7 @@ func TestCassandraParseConnectionString_WithACorrectConnectionString_Passes(t *t
 func TestCassandraParseConnectionString_WithAUserParameterButWithoutPasswordOne_ReturnsAnError(t *testing.T) {
 	ci := newCassandraConnectionInfo()
 	ci.value = fmt.Sprintf(
-		"cassandra://%s@%s/%s?cassandraDc=%s",
+		"cassandra://%s@%s/%s?dc=%s",
 		cassandraUsername,
 		cassandraHost1,
 		cassandraKeyspace,
@@ -111,7 +112,7 @@ func TestCassandraParseConnectionString_WithAUserParameterButWithoutPasswordOne_
 func TestCassandraParseConnectionString_WithAnAuthParameters_Passes(t *testing.T) {
 	ci := newCassandraConnectionInfo()
 	ci.value = fmt.Sprintf(
-		"cassandra://%s:%s@%s,%s/%s?cassandraDc=%s",
+		"cassandra://%s:%s@%s,%s/%s?dc=%s",
 		cassandraUsername,
 		cassandraPassword,
 		cassandraHost1,
@@ -126,6 +127,7 @@ func TestCassandraParseConnectionString_WithAnAuthParameters_Passes(t *testing.T
 	assert.Equal(t, cassandraUsername, ci.GetUser())
 	assert.Equal(t, cassandraPassword, ci.GetPassword())
 	assert.Equal(t, []string{cassandraHost1, cassandraHost2}, ci.GetHosts())
+	assert.Equal(t, cassandraDc, ci.GetDataCenter())
 }
 
 func TestCassandraParseConnectionString_WithSeveralHosts_Passes(t *testing.T) {
@@ -148,7 +150,7 @@ func TestCassandraRegisterCassandraParser_WithoutParameters_PassesAndRegistersAP
 	config := NewConfig()
 
 	config.RegisterCassandraParameter(CassandraTestParameter)
-	err := config.Parse()
+	err := config.ParseFrom(nil, getEnvironment())
 
 	assert.Error(t, err)
 }
@@ -162,10 +164,82 @@ func TestCassandraRegisterCassandraParser_WithParameters_PassesAndRegistersAPars
 	config := NewConfig()
 
 	config.RegisterCassandraParameter(CassandraTestParameter)
-	errParsing := config.Parse()
+	errParsing := config.ParseFrom(nil, getEnvironment())
 	connectionString, err := config.GetCassandraParameter(CassandraTestParameter)
 
 	assert.Empty(t, errParsing)
 	assert.Empty(t, err)
 	assert.Equal(t, cassandraConnectionString, connectionString.GetValue())
 }
+
+func TestCassandraValidate_WithConnectionOptions_Passes(t *testing.T) {
+	ci := newCassandraConnectionInfo()
+	ci.value = "cassandra://host1.com,host2.com/keyspace?dc=dc1&port=9043&connect_timeout=2s&timeout=1s" +
+		"&consistency=local_one&protocol_version=4&compression=snappy&num_conns=3" +
+		"&tls_ca_file=/ca.pem&tls_cert_file=/cert.pem&tls_key_file=/key.pem"
+
+	err := ci.validate()
+
+	assert.Empty(t, err)
+	assert.Equal(t, "dc1", ci.GetDataCenter())
+	assert.Equal(t, 9043, ci.GetPort())
+	assert.Equal(t, 2*time.Second, ci.GetConnectTimeout())
+	assert.Equal(t, time.Second, ci.GetTimeout())
+	assert.Equal(t, "LOCAL_ONE", ci.GetConsistency())
+	assert.Equal(t, 4, ci.GetProtocolVersion())
+	assert.Equal(t, CassandraCompressionSnappy, ci.GetCompression())
+	assert.Equal(t, 3, ci.GetNumConns())
+	assert.True(t, ci.IsTLS())
+	assert.Equal(t, "/ca.pem", ci.GetTLSCAFile())
+	assert.Equal(t, "/cert.pem", ci.GetTLSCertFile())
+	assert.Equal(t, "/key.pem", ci.GetTLSKeyFile())
+}
+
+func TestCassandraValidate_WithAnIncorrectConsistency_ReturnsAnError(t *testing.T) {
+	ci := newCassandraConnectionInfo()
+	ci.value = "cassandra://host.com/keyspace?consistency=most"
+
+	err := ci.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrCassandraOptionIsIncorrect, err)
+}
+
+func TestCassandraValidate_WithAnUnknownOption_ReturnsAnError(t *testing.T) {
+	ci := newCassandraConnectionInfo()
+	ci.value = "cassandra://host.com/keyspace?consistancy=ONE"
+
+	err := ci.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrCassandraOptionIsUnknown, err)
+}
+
+func TestCassandraValidate_WithTheLegacyDataCenterKey_ReturnsAnError(t *testing.T) {
+	ci := newCassandraConnectionInfo()
+	ci.value = "cassandra://host.com/keyspace?cassandraDc=US"
+
+	err := ci.validate()
+
+	helper.AssertError(t, ErrCassandraOptionIsUnknown, err)
+	assert.Contains(t, err.Error(), "cassandraDc")
+}
+
+func TestCassandraValidate_WithTLSDisabledAndACAFile_ReturnsAnError(t *testing.T) {
+	ci := newCassandraConnectionInfo()
+	ci.value = "cassandra://host.com/keyspace?tls=false&tls_ca_file=/ca.pem"
+
+	err := ci.validate()
+
+	helper.AssertError(t, ErrCassandraOptionIsIncorrect, err)
+}
+
+func TestCassandraValidate_WithACertFileWithoutAKeyFile_ReturnsAnError(t *testing.T) {
+	ci := newCassandraConnectionInfo()
+	ci.value = "cassandra://host.com/keyspace?tls_cert_file=/cert.pem"
+
+	err := ci.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrCassandraOptionIsIncorrect, err)
+}
@@ -0,0 +1,71 @@
+package cfg
+
+import (
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// ConfigFileInfoProvider declares the configuration file parameter getters.
+//
+type ConfigFileInfoProvider interface {
+	GetPath() string
+	GetFormat() string
+
+	ParameterInfoProvider
+}
+
+//
+// ConfigFileInfo is a configuration file path parameter.
+// The parameter is optional: an empty value means there is no configuration file to read.
+//
+type ConfigFileInfo struct {
+	format string
+
+	*StringParameter
+}
+
+//
+// newConfigFileInfo returns a new configuration file info object instance.
+//
+func newConfigFileInfo() *ConfigFileInfo {
+	return &ConfigFileInfo{
+		StringParameter: &StringParameter{},
+	}
+}
+
+//
+// GetPath returns the configuration file path.
+//
+func (p *ConfigFileInfo) GetPath() string {
+
+	return p.GetValue()
+}
+
+//
+// GetFormat returns the configuration file format.
+//
+func (p *ConfigFileInfo) GetFormat() string {
+
+	return p.format
+}
+
+//
+// validate validates the configuration file to be of a supported format.
+//
+func (p *ConfigFileInfo) validate() error {
+	path := p.GetPath()
+	if "" == path {
+		return nil
+	}
+
+	p.format = getFileFormat(path)
+	if "" == p.format {
+		return errors.WithMessage(
+			ErrConfigFileFormatIsNotSupported,
+			`kit-cfg@ConfigFileInfo.validate [parameter (%s), value (%s)]`,
+			p.GetName(), path,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package cfg
+
+import (
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// CustomParameter is an interface for the application defined configuration parameter types.
+// Custom parameter types embed the *StringParameter passed to their ParameterFactory and implement Validate to parse
+// and validate the value. Validate is called for the non-empty values only. The embedded *StringParameter provides the
+// unexported ParameterInfoProvider methods, so the custom types are defined in the application packages.
+//
+type CustomParameter interface {
+	ParameterInfoProvider
+
+	//
+	// Validate parses and validates the configuration parameter value.
+	//
+	Validate() error
+}
+
+//
+// ParameterFactory returns a new custom parameter instance embedding the passed base string parameter.
+//
+type ParameterFactory func(*StringParameter) CustomParameter
+
+//
+// RegisterParameter registers a custom configuration parameter type created by the factory.
+//
+func (c *Config) RegisterParameter(param Parameter, factory ParameterFactory, options ...ParameterOption) {
+	c.register(factory(newStringParameter(param)), options)
+}
+
+//
+// GetCustomParameter returns a custom parameter info.
+//
+func (c *Config) GetCustomParameter(param Parameter) (CustomParameter, error) {
+	parameter, ok := c.getParameterEntry(param).(CustomParameter)
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			"kit-cfg@Config.GetCustomParameter",
+		)
+	}
+
+	return parameter, nil
+}
+
+//
+// GetParameter returns a registered parameter info of the requested type.
+// It returns ErrGetMisregisteredConfigParameter if the parameter is not registered or has a different type.
+//
+func GetParameter[T ParameterInfoProvider](c *Config, param Parameter) (T, error) {
+	parameter, ok := c.getParameterEntry(param).(T)
+	if !ok {
+		var empty T
+		return empty, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			`kit-cfg@GetParameter [parameter (%s)]`,
+			param,
+		)
+	}
+
+	return parameter, nil
+}
+
+//
+// validateCustomParameter validates the custom parameter value with the application defined validation.
+//
+func validateCustomParameter(parameterEntry ParameterInfoProvider) error {
+	customParameter, ok := parameterEntry.(CustomParameter)
+	if !ok {
+		return nil
+	}
+
+	if err := customParameter.Validate(); nil != err {
+		return errors.WithMessage(err, `kit-cfg@validateCustomParameter [parameter (%s)]`, parameterEntry.GetName())
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package cfg
+
+import (
+	"time"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// DurationInfoProvider declares the duration parameter getters.
+//
+type DurationInfoProvider interface {
+	GetDuration() time.Duration
+
+	ParameterInfoProvider
+}
+
+//
+// DurationInfo is a duration configuration parameter, i.e. 300ms, 1.5s or 2h45m.
+//
+type DurationInfo struct {
+	duration time.Duration
+	hasRange bool
+	min      time.Duration
+	max      time.Duration
+
+	*StringParameter
+}
+
+//
+// newDurationInfo returns a new duration parameter info object instance.
+//
+func newDurationInfo() *DurationInfo {
+	return &DurationInfo{
+		StringParameter: &StringParameter{},
+	}
+}
+
+//
+// GetDuration returns the parsed parameter value.
+//
+func (p *DurationInfo) GetDuration() time.Duration {
+
+	return p.duration
+}
+
+//
+// setDurationRange sets the inclusive range for the parameter value.
+//
+func (p *DurationInfo) setDurationRange(min, max time.Duration) {
+	p.hasRange = true
+	p.min = min
+	p.max = max
+}
+
+//
+// validate validates the parameter value to be a duration within the range if any.
+//
+func (p *DurationInfo) validate() error {
+	var err error
+	if err = p.StringParameter.validate(); nil != err {
+		return err
+	}
+
+	errMsg := `kit-cfg@DurationInfo.validate [parameter (%s), value (%s)]`
+	if p.duration, err = parseDuration(p.GetValue()); nil != err {
+		return errors.WrapError(
+			ErrConfigParameterIsNotADuration,
+			errors.WithMessage(err, errMsg, p.GetName(), p.GetRedactedValue()),
+		)
+	}
+
+	if p.hasRange && (p.duration < p.min || p.duration > p.max) {
+		return errors.WithMessage(
+			ErrConfigParameterIsOutOfRange,
+			errMsg+` [range (%s..%s)]`,
+			p.GetName(), p.GetRedactedValue(), p.min, p.max,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestDurationValidate_WithANotDurationValue_ReturnsAnError(t *testing.T) {
+	di := newDurationInfo()
+	di.value = "10"
+
+	err := di.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsNotADuration, err)
+}
+
+func TestDurationValidate_WithAValueOutOfRange_ReturnsAnError(t *testing.T) {
+	di := newDurationInfo()
+	di.value = "1m"
+	di.setDurationRange(time.Second, 10*time.Second)
+
+	err := di.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsOutOfRange, err)
+}
+
+func TestDurationValidate_WithACorrectValue_Passes(t *testing.T) {
+	di := newDurationInfo()
+	di.value = "5s"
+
+	err := di.validate()
+
+	assert.Empty(t, err)
+	assert.Equal(t, 5*time.Second, di.GetDuration())
+}
+
+func TestDurationValidate_WithAnIncorrectSecretValue_DoesNotLeakTheValue(t *testing.T) {
+	di := newDurationInfo()
+	di.value = "s3cr%t"
+	di.secret = true
+
+	err := di.validate()
+
+	helper.AssertError(t, ErrConfigParameterIsNotADuration, err)
+	assert.NotContains(t, err.Error(), "s3cr")
+	assert.NotContains(t, err.Error(), "%!")
+}
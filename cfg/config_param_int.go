@@ -0,0 +1,80 @@
+package cfg
+
+import (
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// IntInfoProvider declares the integer parameter getters.
+//
+type IntInfoProvider interface {
+	GetInt() int
+
+	ParameterInfoProvider
+}
+
+//
+// IntInfo is an integer configuration parameter.
+//
+type IntInfo struct {
+	intValue int
+	hasRange bool
+	min      int
+	max      int
+
+	*StringParameter
+}
+
+//
+// newIntInfo returns a new integer parameter info object instance.
+//
+func newIntInfo() *IntInfo {
+	return &IntInfo{
+		StringParameter: &StringParameter{},
+	}
+}
+
+//
+// GetInt returns the parsed parameter value.
+//
+func (p *IntInfo) GetInt() int {
+
+	return p.intValue
+}
+
+//
+// setRange sets the inclusive range for the parameter value.
+//
+func (p *IntInfo) setRange(min, max int) {
+	p.hasRange = true
+	p.min = min
+	p.max = max
+}
+
+//
+// validate validates the parameter value to be an integer within the range if any.
+//
+func (p *IntInfo) validate() error {
+	var err error
+	if err = p.StringParameter.validate(); nil != err {
+		return err
+	}
+
+	errMsg := `kit-cfg@IntInfo.validate [parameter (%s), value (%s)]`
+	if p.intValue, err = parseInt(p.GetValue()); nil != err {
+		return errors.WrapError(
+			ErrConfigParameterIsNotAnInteger,
+			errors.WithMessage(err, errMsg, p.GetName(), p.GetRedactedValue()),
+		)
+	}
+
+	if p.hasRange && (p.intValue < p.min || p.intValue > p.max) {
+		return errors.WithMessage(
+			ErrConfigParameterIsOutOfRange,
+			errMsg+` [range (%d..%d)]`,
+			p.GetName(), p.GetRedactedValue(), p.min, p.max,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package cfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestIntValidate_WithANotIntegerValue_ReturnsAnError(t *testing.T) {
+	ii := newIntInfo()
+	ii.value = "ten"
+
+	err := ii.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsNotAnInteger, err)
+}
+
+func TestIntValidate_WithAValueOutOfRange_ReturnsAnError(t *testing.T) {
+	ii := newIntInfo()
+	ii.value = "100"
+	ii.setRange(1, 10)
+
+	err := ii.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsOutOfRange, err)
+}
+
+func TestIntValidate_WithACorrectValue_Passes(t *testing.T) {
+	ii := newIntInfo()
+	ii.value = "10"
+	ii.setRange(1, 10)
+
+	err := ii.validate()
+
+	assert.Empty(t, err)
+	assert.Equal(t, 10, ii.GetInt())
+}
+
+func TestParse_WithAnIntParameterOutOfTheRegisteredRange_ReturnsAnError(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{string(TestParameter): "0"}))
+
+	config.RegisterIntParameter(TestParameter, Range(1, 10))
+	err := config.Parse()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsOutOfRange, err)
+}
+
+func TestIntValidate_WithAnIncorrectSecretValue_DoesNotLeakTheValue(t *testing.T) {
+	ii := newIntInfo()
+	ii.value = "s3cr%t"
+	ii.secret = true
+
+	err := ii.validate()
+
+	helper.AssertError(t, ErrConfigParameterIsNotAnInteger, err)
+	assert.NotContains(t, err.Error(), "s3cr")
+	assert.NotContains(t, err.Error(), "%!")
+}
@@ -0,0 +1,84 @@
+package cfg
+
+import (
+	"strings"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// ListSeparator separates the list parameter items.
+//
+const ListSeparator = ","
+
+//
+// ListInfoProvider declares the list parameter getters.
+//
+type ListInfoProvider interface {
+	GetList() []string
+
+	ParameterInfoProvider
+}
+
+//
+// ListInfo is a comma separated list configuration parameter.
+// List items are trimmed, empty items are skipped.
+//
+type ListInfo struct {
+	items         []string
+	allowedValues []string
+
+	*StringParameter
+}
+
+//
+// newListInfo returns a new list parameter info object instance.
+//
+func newListInfo() *ListInfo {
+	return &ListInfo{
+		StringParameter: &StringParameter{},
+	}
+}
+
+//
+// GetList returns the list items.
+//
+func (p *ListInfo) GetList() []string {
+
+	return p.items
+}
+
+//
+// setAllowedValues sets the list of allowed list items.
+//
+func (p *ListInfo) setAllowedValues(values []string) {
+	p.allowedValues = values
+}
+
+//
+// validate validates the list items to be allowed if the allowed values were set.
+//
+func (p *ListInfo) validate() error {
+	if err := p.StringParameter.validate(); nil != err {
+		return err
+	}
+
+	p.items = nil
+	for _, item := range strings.Split(p.GetValue(), ListSeparator) {
+		item = strings.TrimSpace(item)
+		if "" == item {
+			continue
+		}
+
+		if 0 != len(p.allowedValues) && !isValueAllowed(item, p.allowedValues) {
+			return errors.WithMessage(
+				ErrConfigParameterIsNotAllowed,
+				`kit-cfg@ListInfo.validate [parameter (%s), value (%s), allowed values (%s)]`,
+				p.GetName(), p.GetRedactedValue(), strings.Join(p.allowedValues, ", "),
+			)
+		}
+		p.items = append(p.items, item)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package cfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestListValidate_WithANotAllowedItem_ReturnsAnError(t *testing.T) {
+	li := newListInfo()
+	li.value = "cards, keys"
+	li.setAllowedValues([]string{"cards", "devportal"})
+
+	err := li.validate()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsNotAllowed, err)
+}
+
+func TestListValidate_WithACorrectValue_ReturnsTrimmedItems(t *testing.T) {
+	li := newListInfo()
+	li.value = " cards, ,devportal "
+
+	err := li.validate()
+
+	assert.Empty(t, err)
+	assert.Equal(t, []string{"cards", "devportal"}, li.GetList())
+}
+
+func TestParse_WithAListInAConfigFile_JoinsTheItems(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "TEST_PARAMETER:\n  - cards\n  - devportal\n")
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+
+	config.Register(ConfigFile)
+	config.RegisterListParameter(TestParameter)
+	err := config.Parse()
+	list, errList := config.GetListParameter(TestParameter)
+
+	assert.Empty(t, err)
+	assert.Empty(t, errList)
+	assert.Equal(t, []string{"cards", "devportal"}, list.GetList())
+}
@@ -11,7 +11,7 @@ import (
 type LogInfoProvider interface {
 	ParameterInfoProvider
 
-	GetHost() string
+	GetSeverity() string
 }
 
 //
@@ -33,7 +33,11 @@ func (l *LogInfo) validate() error {
 	}
 	severity := l.GetValue()
 	if severity != log.SeverityDebug && severity != log.SeverityError && severity != log.SeverityInfo {
-		return errors.WithMessage(ErrLogSeverityIncorrectValue, `kit-cfg@LogInfo.validate [value (%s)]`, severity)
+		return errors.WithMessage(
+			ErrLogSeverityIncorrectValue,
+			`kit-cfg@LogInfo.validate [value (%s)]`,
+			l.GetRedactedValue(),
+		)
 	}
 
 	l.severity = severity
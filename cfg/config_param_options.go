@@ -0,0 +1,119 @@
+package cfg
+
+import (
+	"time"
+)
+
+//
+// ParameterOption is a configuration parameter registration option.
+//
+type ParameterOption func(ParameterInfoProvider)
+
+//
+// intRangeSetter is implemented by the parameters supporting the integer range validation.
+//
+type intRangeSetter interface {
+	setRange(min, max int)
+}
+
+//
+// durationRangeSetter is implemented by the parameters supporting the duration range validation.
+//
+type durationRangeSetter interface {
+	setDurationRange(min, max time.Duration)
+}
+
+//
+// allowedValuesSetter is implemented by the parameters supporting the allowed values validation.
+//
+type allowedValuesSetter interface {
+	setAllowedValues(values []string)
+}
+
+//
+// Secret marks the parameter value as secret.
+// Secret values are redacted in validation errors, configuration dumps and log output.
+//
+func Secret() ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		p.getStringParameter().secret = true
+	}
+}
+
+//
+// Optional marks the parameter as optional, i.e. an empty parameter value passes the validation.
+//
+func Optional() ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		p.getStringParameter().optional = true
+	}
+}
+
+//
+// Default sets the parameter value used when none of the providers has set it.
+// A parameter with a default value is optional.
+//
+func Default(value string) ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		p.getStringParameter().defaultValue = value
+	}
+}
+
+//
+// Description sets the parameter description for the help output and the reference.
+//
+func Description(text string) ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		p.getStringParameter().description = text
+	}
+}
+
+//
+// Example sets the parameter value example for the help output and the reference.
+//
+func Example(value string) ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		p.getStringParameter().example = value
+	}
+}
+
+//
+// Range sets the inclusive range for the integer parameter value.
+//
+func Range(min, max int) ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		if setter, ok := p.(intRangeSetter); ok {
+			setter.setRange(min, max)
+		}
+	}
+}
+
+//
+// DurationRange sets the inclusive range for the duration parameter value.
+//
+func DurationRange(min, max time.Duration) ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		if setter, ok := p.(durationRangeSetter); ok {
+			setter.setDurationRange(min, max)
+		}
+	}
+}
+
+//
+// OneOf sets the list of allowed values for the parameter value. For the list parameters each list item is checked.
+//
+func OneOf(values ...string) ParameterOption {
+
+	return func(p ParameterInfoProvider) {
+		if setter, ok := p.(allowedValuesSetter); ok {
+			setter.setAllowedValues(values)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package cfg
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// PostgreSQL connection URL constants.
+//
+const (
+	PostgresConnectionProtocol      = "postgres"
+	PostgresAliasConnectionProtocol = "postgresql"
+)
+
+//
+// PostgreSQL connection URL pool options. They are removed from the driver data source name.
+//
+const (
+	PostgresOptionMaxOpenConns    = "max_open_conns"
+	PostgresOptionMaxIdleConns    = "max_idle_conns"
+	PostgresOptionConnMaxLifetime = "conn_max_lifetime"
+	PostgresOptionConnMaxIdleTime = "conn_max_idle_time"
+)
+
+//
+// PostgresConnectionInfo is a PostgreSQL database connection info parameter.
+// The connection string format is
+// postgres[ql]://[user[:password]@]host[:port]/database[?option=value...]
+// where the pool options are max_open_conns, max_idle_conns, conn_max_lifetime and conn_max_idle_time. The other
+// options, like sslmode, are passed to the driver.
+//
+type PostgresConnectionInfo struct {
+	host            string
+	port            string
+	user            string
+	password        string
+	database        string
+	dsn             string
+	maxOpenConns    int
+	maxIdleConns    int
+	connMaxLifetime time.Duration
+	connMaxIdleTime time.Duration
+
+	*StringParameter
+}
+
+//
+// PostgresConnectionInfoProvider declares all the connection info getters.
+//
+type PostgresConnectionInfoProvider interface {
+	GetHost() string
+	GetPort() string
+	GetUser() string
+	GetPassword() string
+	GetDatabase() string
+	GetDSN() string
+	GetMaxOpenConns() int
+	GetMaxIdleConns() int
+	GetConnMaxLifetime() time.Duration
+	GetConnMaxIdleTime() time.Duration
+
+	ParameterInfoProvider
+}
+
+//
+// newPostgresConnectionInfo returns a new PostgresConnectionInfo object instance.
+//
+func newPostgresConnectionInfo() *PostgresConnectionInfo {
+	return &PostgresConnectionInfo{
+		StringParameter: &StringParameter{},
+	}
+}
+
+//
+// GetHost returns the host.
+//
+func (c *PostgresConnectionInfo) GetHost() string {
+
+	return c.host
+}
+
+//
+// GetPort returns the port or an empty string if it is not set.
+//
+func (c *PostgresConnectionInfo) GetPort() string {
+
+	return c.port
+}
+
+//
+// GetUser returns the user name.
+//
+func (c *PostgresConnectionInfo) GetUser() string {
+
+	return c.user
+}
+
+//
+// GetPassword returns the password.
+//
+func (c *PostgresConnectionInfo) GetPassword() string {
+
+	return c.password
+}
+
+//
+// GetDatabase returns the database name.
+//
+func (c *PostgresConnectionInfo) GetDatabase() string {
+
+	return c.database
+}
+
+//
+// GetDSN returns the driver data source name, i.e. the connection string without the pool options.
+//
+func (c *PostgresConnectionInfo) GetDSN() string {
+
+	return c.dsn
+}
+
+//
+// GetMaxOpenConns returns the maximum number of open connections or zero if it is not set.
+//
+func (c *PostgresConnectionInfo) GetMaxOpenConns() int {
+
+	return c.maxOpenConns
+}
+
+//
+// GetMaxIdleConns returns the maximum number of idle connections or zero if it is not set.
+//
+func (c *PostgresConnectionInfo) GetMaxIdleConns() int {
+
+	return c.maxIdleConns
+}
+
+//
+// GetConnMaxLifetime returns the maximum connection lifetime or zero if it is not set.
+//
+func (c *PostgresConnectionInfo) GetConnMaxLifetime() time.Duration {
+
+	return c.connMaxLifetime
+}
+
+//
+// GetConnMaxIdleTime returns the maximum connection idle time or zero if it is not set.
+//
+func (c *PostgresConnectionInfo) GetConnMaxIdleTime() time.Duration {
+
+	return c.connMaxIdleTime
+}
+
+//
+// validate validates the PostgreSQL connection string parameter.
+//
+func (c *PostgresConnectionInfo) validate() error {
+	var err error
+	var connectionInfo *url.URL
+
+	connectionString := c.GetValue()
+	errMsg := `kit-cfg@PostgresConnectionInfo.validate [connection string (%s)]`
+
+	if "" == connectionString {
+		return ErrPostgresConnectionStringIsEmpty
+	}
+
+	if connectionInfo, err = parseURL(connectionString); nil != err {
+		return errors.WrapError(ErrPostgresConnectionStringIsIncorrect, errors.WithMessage(err, errMsg, c.GetRedactedValue()))
+	}
+
+	if PostgresAliasConnectionProtocol != connectionInfo.Scheme {
+		if _, err := validateConnectionProtocolClause(connectionInfo, PostgresConnectionProtocol); nil != err {
+			return errors.WrapError(ErrPostgresProtocolIsIncorrect, errors.WithMessage(err, errMsg, c.GetRedactedValue()))
+		}
+	}
+
+	if c.host = connectionInfo.Hostname(); "" == c.host {
+		return errors.WithMessage(ErrPostgresHostIsEmpty, errMsg, c.GetRedactedValue())
+	}
+	c.port = connectionInfo.Port()
+
+	if c.database = strings.Trim(connectionInfo.Path, "/"); "" == c.database {
+		return errors.WithMessage(ErrPostgresDatabaseIsEmpty, errMsg, c.GetRedactedValue())
+	}
+
+	c.user, c.password = "", ""
+	if nil != connectionInfo.User {
+		c.user = connectionInfo.User.Username()
+		c.password, _ = connectionInfo.User.Password()
+	}
+
+	if err = c.validatePoolOptionsClause(connectionInfo); nil != err {
+		return errors.WithMessage(err, errMsg, c.GetRedactedValue())
+	}
+
+	return nil
+}
+
+//
+// validatePoolOptionsClause validates the pool options and sets the data source name without them.
+//
+func (c *PostgresConnectionInfo) validatePoolOptionsClause(connectionInfo *url.URL) error {
+	var err error
+	c.maxOpenConns, c.maxIdleConns, c.connMaxLifetime, c.connMaxIdleTime = 0, 0, 0, 0
+
+	query := connectionInfo.Query()
+	for option, values := range query {
+		value := values[len(values)-1]
+		switch option {
+		case PostgresOptionMaxOpenConns:
+			c.maxOpenConns, err = parsePostgresPositiveIntOption(value)
+		case PostgresOptionMaxIdleConns:
+			c.maxIdleConns, err = parsePostgresPositiveIntOption(value)
+		case PostgresOptionConnMaxLifetime:
+			c.connMaxLifetime, err = parsePostgresDurationOption(value)
+		case PostgresOptionConnMaxIdleTime:
+			c.connMaxIdleTime, err = parsePostgresDurationOption(value)
+		default:
+			continue
+		}
+
+		if nil != err {
+			return errors.WrapError(ErrPostgresOptionIsIncorrect, errors.WithMessage(err, `[option (%s)]`, option))
+		}
+		query.Del(option)
+	}
+
+	dsn := *connectionInfo
+	dsn.RawQuery = query.Encode()
+	c.dsn = dsn.String()
+
+	return nil
+}
+
+//
+// parsePostgresPositiveIntOption parses the positive integer option value.
+//
+func parsePostgresPositiveIntOption(value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if nil == err && number <= 0 {
+		return 0, errors.New("value must be positive")
+	}
+
+	return number, err
+}
+
+//
+// parsePostgresDurationOption parses the positive duration option value.
+//
+func parsePostgresDurationOption(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if nil == err && duration <= 0 {
+		return 0, errors.New("duration must be positive")
+	}
+
+	return duration, err
+}
@@ -1,9 +1,22 @@
 package cfg
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/ameteiko/golang-kit/errors"
 )
 
+//
+// RedactedValue replaces the secret values in errors, dumps and logs.
+//
+const RedactedValue = "xxxxx"
+
+//
+// urlPasswordRegexp matches the URL user info password.
+//
+var urlPasswordRegexp = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.\-]*://[^:/@?#\s]*):[^@/?#\s]*@`)
+
 //
 // ParameterInfoProvider is an interface for the String parameter entry.
 //
@@ -23,6 +36,41 @@ type ParameterInfoProvider interface {
 	//
 	GetName() string
 
+	//
+	// IsSecret returns true if the parameter value is secret.
+	//
+	IsSecret() bool
+
+	//
+	// GetRedactedValue returns a parameter value safe for errors, dumps and logs.
+	//
+	GetRedactedValue() string
+
+	//
+	// IsOptional returns true if the parameter value might be empty.
+	//
+	IsOptional() bool
+
+	//
+	// GetDefaultValue returns the value used when none of the providers has set the parameter.
+	//
+	GetDefaultValue() string
+
+	//
+	// GetDescription returns the parameter description for the help output and the reference.
+	//
+	GetDescription() string
+
+	//
+	// GetExample returns the parameter value example for the help output and the reference.
+	//
+	GetExample() string
+
+	//
+	// getStringParameter returns the base string parameter to apply the registration options to.
+	//
+	getStringParameter() *StringParameter
+
 	//
 	// validate validates the string configuration parameter value not to be an empty string.
 	//
@@ -35,8 +83,14 @@ type ParameterInfoProvider interface {
 // service URL, etc.
 //
 type StringParameter struct {
-	name  string
-	value string
+	name          string
+	value         string
+	secret        bool
+	optional      bool
+	defaultValue  string
+	allowedValues []string
+	description   string
+	example       string
 }
 
 //
@@ -63,6 +117,78 @@ func (p *StringParameter) GetName() string {
 	return p.name
 }
 
+//
+// IsSecret returns true if the parameter value is secret.
+//
+func (p *StringParameter) IsSecret() bool {
+
+	return p.secret
+}
+
+//
+// GetRedactedValue returns a parameter value safe for errors, dumps and logs.
+// Secret values are replaced completely, URL user info passwords are replaced for all the parameters.
+//
+func (p *StringParameter) GetRedactedValue() string {
+	if "" == p.value {
+		return ""
+	}
+
+	if p.secret {
+		return RedactedValue
+	}
+
+	return redactURLPasswords(p.value)
+}
+
+//
+// IsOptional returns true if the parameter value might be empty.
+//
+func (p *StringParameter) IsOptional() bool {
+
+	return p.optional || "" != p.defaultValue
+}
+
+//
+// GetDefaultValue returns the value used when none of the providers has set the parameter.
+//
+func (p *StringParameter) GetDefaultValue() string {
+
+	return p.defaultValue
+}
+
+//
+// GetDescription returns the parameter description for the help output and the reference.
+//
+func (p *StringParameter) GetDescription() string {
+
+	return p.description
+}
+
+//
+// GetExample returns the parameter value example for the help output and the reference.
+//
+func (p *StringParameter) GetExample() string {
+
+	return p.example
+}
+
+//
+// String returns a redacted parameter value to prevent secrets leaking into logs.
+//
+func (p *StringParameter) String() string {
+
+	return p.GetRedactedValue()
+}
+
+//
+// getStringParameter returns the base string parameter.
+//
+func (p *StringParameter) getStringParameter() *StringParameter {
+
+	return p
+}
+
 //
 // validate validates the string configuration parameter value not to be an empty string.
 //
@@ -75,5 +201,41 @@ func (p *StringParameter) validate() error {
 		)
 	}
 
+	if 0 != len(p.allowedValues) && !isValueAllowed(p.GetValue(), p.allowedValues) {
+		return errors.WithMessage(
+			ErrConfigParameterIsNotAllowed,
+			`kit-cfg@StringParameter.validate [parameter (%s), value (%s), allowed values (%s)]`,
+			p.GetName(), p.GetRedactedValue(), strings.Join(p.allowedValues, ", "),
+		)
+	}
+
 	return nil
 }
+
+//
+// setAllowedValues sets the list of allowed parameter values.
+//
+func (p *StringParameter) setAllowedValues(values []string) {
+	p.allowedValues = values
+}
+
+//
+// redactURLPasswords replaces all URL user info passwords in the text.
+//
+func redactURLPasswords(text string) string {
+
+	return urlPasswordRegexp.ReplaceAllString(text, "${1}:"+RedactedValue+"@")
+}
+
+//
+// isValueAllowed returns true if the value is in the list of allowed values.
+//
+func isValueAllowed(value string, allowedValues []string) bool {
+	for _, allowedValue := range allowedValues {
+		if value == allowedValue {
+			return true
+		}
+	}
+
+	return false
+}
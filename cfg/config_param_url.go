@@ -1,7 +1,6 @@
 package cfg
 
 import (
-	"net/url"
 	"regexp"
 
 	"github.com/ameteiko/golang-kit/errors"
@@ -45,11 +44,11 @@ func (c *URLInfo) validate() error {
 	}
 
 	urlValue := c.GetValue()
-	urlInfo, err := url.Parse(urlValue)
+	urlInfo, err := parseURL(urlValue)
 	if nil != err {
 		return errors.WrapError(
 			ErrURLIncorrectValue,
-			errors.WithMessage(err, `kit-cfg@URLInfo.validate [value (%s)]`, urlValue),
+			errors.WithMessage(err, `kit-cfg@URLInfo.validate [value (%s)]`, c.GetRedactedValue()),
 		)
 	}
 	c.host = urlInfo.Host
@@ -2,9 +2,10 @@ package cfg
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
-	"fmt"
 	"github.com/ameteiko/golang-kit/errors"
 )
 
@@ -12,15 +13,42 @@ import (
 // Redis connection URL constants.
 //
 const (
-	RedisConnectionProtocol = "redis"
+	RedisConnectionProtocol    = "redis"
+	RedisTLSConnectionProtocol = "rediss"
+)
+
+//
+// Redis connection URL query options.
+//
+const (
+	RedisOptionDialTimeout    = "dial_timeout"
+	RedisOptionReadTimeout    = "read_timeout"
+	RedisOptionWriteTimeout   = "write_timeout"
+	RedisOptionPoolSize       = "pool_size"
+	RedisOptionSentinelMaster = "sentinel_master"
+	RedisOptionCluster        = "cluster"
 )
 
 //
 // RedisConnectionInfo is a redis connection info parameter.
+// The connection string format is
+// redis[s]://[:password@]host:port[,host:port...][/db][?option=value...]
+// where rediss enables TLS and the options are dial_timeout, read_timeout, write_timeout, pool_size, sentinel_master
+// (the sentinel master name, the hosts are the sentinel hosts) and cluster=true (the hosts are the cluster nodes).
 //
 type RedisConnectionInfo struct {
-	host string
-	port string
+	host           string
+	port           string
+	addresses      []string
+	password       string
+	db             int
+	tls            bool
+	dialTimeout    time.Duration
+	readTimeout    time.Duration
+	writeTimeout   time.Duration
+	poolSize       int
+	sentinelMaster string
+	cluster        bool
 
 	*StringParameter
 }
@@ -31,6 +59,18 @@ type RedisConnectionInfo struct {
 type RedisConnectionInfoProvider interface {
 	GetHost() string
 	GetPort() string
+	GetAddresses() []string
+	GetPassword() string
+	GetDB() int
+	GetDialTimeout() time.Duration
+	GetReadTimeout() time.Duration
+	GetWriteTimeout() time.Duration
+	GetPoolSize() int
+	GetSentinelMaster() string
+
+	IsTLS() bool
+	IsSentinel() bool
+	IsCluster() bool
 
 	ParameterInfoProvider
 }
@@ -45,7 +85,7 @@ func newRedisConnectionInfo() *RedisConnectionInfo {
 }
 
 //
-// GetHost returns the host.
+// GetHost returns the host of the first address.
 //
 func (c *RedisConnectionInfo) GetHost() string {
 
@@ -53,13 +93,101 @@ func (c *RedisConnectionInfo) GetHost() string {
 }
 
 //
-// GetPort returns port value.
+// GetPort returns port value of the first address.
 //
 func (c *RedisConnectionInfo) GetPort() string {
 
 	return c.port
 }
 
+//
+// GetAddresses returns the host:port addresses of the server, the sentinels or the cluster nodes.
+//
+func (c *RedisConnectionInfo) GetAddresses() []string {
+
+	return c.addresses
+}
+
+//
+// GetPassword returns the password.
+//
+func (c *RedisConnectionInfo) GetPassword() string {
+
+	return c.password
+}
+
+//
+// GetDB returns the database index.
+//
+func (c *RedisConnectionInfo) GetDB() int {
+
+	return c.db
+}
+
+//
+// GetDialTimeout returns the dial timeout or zero if it is not set.
+//
+func (c *RedisConnectionInfo) GetDialTimeout() time.Duration {
+
+	return c.dialTimeout
+}
+
+//
+// GetReadTimeout returns the read timeout or zero if it is not set.
+//
+func (c *RedisConnectionInfo) GetReadTimeout() time.Duration {
+
+	return c.readTimeout
+}
+
+//
+// GetWriteTimeout returns the write timeout or zero if it is not set.
+//
+func (c *RedisConnectionInfo) GetWriteTimeout() time.Duration {
+
+	return c.writeTimeout
+}
+
+//
+// GetPoolSize returns the connection pool size or zero if it is not set.
+//
+func (c *RedisConnectionInfo) GetPoolSize() int {
+
+	return c.poolSize
+}
+
+//
+// GetSentinelMaster returns the sentinel master name.
+//
+func (c *RedisConnectionInfo) GetSentinelMaster() string {
+
+	return c.sentinelMaster
+}
+
+//
+// IsTLS returns true if the connection is secured with TLS.
+//
+func (c *RedisConnectionInfo) IsTLS() bool {
+
+	return c.tls
+}
+
+//
+// IsSentinel returns true if the addresses are the sentinel addresses.
+//
+func (c *RedisConnectionInfo) IsSentinel() bool {
+
+	return "" != c.sentinelMaster
+}
+
+//
+// IsCluster returns true if the addresses are the cluster nodes addresses.
+//
+func (c *RedisConnectionInfo) IsCluster() bool {
+
+	return c.cluster
+}
+
 //
 // validate validates the redis connection string parameter.
 //
@@ -67,52 +195,184 @@ func (c *RedisConnectionInfo) validate() error {
 	var err error
 	var connectionInfo *url.URL
 	connectionString := c.GetValue()
-	errMsg := fmt.Sprintf(`kit-cfg@CassandraConnectionInfo.validate [connection string (%s)]`, connectionString)
+	errMsg := `kit-cfg@RedisConnectionInfo.validate [connection string (%s)]`
 
 	if "" == connectionString {
 		return ErrRedisConnectionStringIsEmpty
 	}
 
-	if connectionInfo, err = url.Parse(connectionString); nil != err {
-		return errors.WrapError(ErrRedisConnectionStringIsIncorrect, errors.WithMessage(err, errMsg))
+	if connectionInfo, err = parseURL(connectionString); nil != err {
+		return errors.WrapError(ErrRedisConnectionStringIsIncorrect, errors.WithMessage(err, errMsg, c.GetRedactedValue()))
+	}
+
+	if c.tls, err = validateRedisProtocolClause(connectionInfo); nil != err {
+		return errors.WrapError(ErrRedisProtocolIsIncorrect, errors.WithMessage(err, errMsg, c.GetRedactedValue()))
+	}
+
+	if c.addresses, err = validateRedisAddressesClause(connectionInfo); nil != err {
+		return errors.WithMessage(err, errMsg, c.GetRedactedValue())
 	}
+	c.host, c.port = splitRedisAddress(c.addresses[0])
 
-	if _, err := validateConnectionProtocolClause(connectionInfo, RedisConnectionProtocol); nil != err {
-		return errors.WrapError(ErrRedisProtocolIsIncorrect, errors.WithMessage(err, errMsg))
+	if c.password, err = validateRedisAuthorizationClause(connectionInfo); nil != err {
+		return errors.WithMessage(err, errMsg, c.GetRedactedValue())
 	}
 
-	if c.host, err = validateRedisHostClause(connectionInfo); nil != err {
-		return errors.WithMessage(err, errMsg)
+	if c.db, err = validateRedisDBClause(connectionInfo); nil != err {
+		return errors.WithMessage(err, errMsg, c.GetRedactedValue())
 	}
 
-	if c.port, err = validateRedisPortClause(connectionInfo); nil != err {
-		return errors.WithMessage(err, errMsg)
+	if err = c.validateOptionsClause(connectionInfo); nil != err {
+		return errors.WithMessage(err, errMsg, c.GetRedactedValue())
+	}
+
+	if err = c.validateTopology(); nil != err {
+		return errors.WithMessage(err, errMsg, c.GetRedactedValue())
 	}
 
 	return nil
 }
 
 //
-// validateHostClause validates the hosts clause.
+// validateOptionsClause validates the query options clause.
 //
-func validateRedisHostClause(url *url.URL) (string, error) {
-	host := strings.Split(url.Host, ":")[0]
+func (c *RedisConnectionInfo) validateOptionsClause(url *url.URL) error {
+	var err error
+	for option, values := range url.Query() {
+		value := values[len(values)-1]
+		switch option {
+		case RedisOptionDialTimeout:
+			c.dialTimeout, err = parseRedisTimeoutOption(value)
+		case RedisOptionReadTimeout:
+			c.readTimeout, err = parseRedisTimeoutOption(value)
+		case RedisOptionWriteTimeout:
+			c.writeTimeout, err = parseRedisTimeoutOption(value)
+		case RedisOptionPoolSize:
+			c.poolSize, err = strconv.Atoi(value)
+			if nil == err && c.poolSize <= 0 {
+				err = errors.New("pool size must be positive")
+			}
+		case RedisOptionSentinelMaster:
+			c.sentinelMaster = value
+		case RedisOptionCluster:
+			c.cluster, err = strconv.ParseBool(value)
+		default:
+			return errors.WithMessage(ErrRedisOptionIsUnknown, `[option (%s)]`, option)
+		}
+
+		if nil != err {
+			return errors.WrapError(ErrRedisOptionIsIncorrect, errors.WithMessage(err, `[option (%s)]`, option))
+		}
+	}
+
+	return nil
+}
 
-	if "" == host {
-		return "", ErrRedisHostIsEmpty
+//
+// validateTopology validates the addresses to match the sentinel, cluster or single server topology.
+//
+func (c *RedisConnectionInfo) validateTopology() error {
+	if c.IsSentinel() && c.IsCluster() {
+		return errors.WithMessage(ErrRedisTopologyIsIncorrect, `sentinel and cluster options are mutually exclusive`)
+	}
+
+	if c.IsCluster() && 0 != c.db {
+		return errors.WithMessage(ErrRedisTopologyIsIncorrect, `cluster supports the database (0) only`)
+	}
+
+	if !c.IsSentinel() && !c.IsCluster() && 1 != len(c.addresses) {
+		return errors.WithMessage(ErrRedisTopologyIsIncorrect, `several hosts require the sentinel or cluster option`)
+	}
+
+	return nil
+}
+
+//
+// validateRedisProtocolClause validates the connection protocol clause and returns true for the TLS protocol.
+//
+func validateRedisProtocolClause(url *url.URL) (bool, error) {
+	if RedisTLSConnectionProtocol == url.Scheme {
+		return true, nil
+	}
+
+	if _, err := validateConnectionProtocolClause(url, RedisConnectionProtocol); nil != err {
+		return false, err
+	}
+
+	return false, nil
+}
+
+//
+// validateRedisAddressesClause validates the comma separated host:port addresses clause.
+//
+func validateRedisAddressesClause(url *url.URL) ([]string, error) {
+	addresses := strings.Split(url.Host, ",")
+	for _, address := range addresses {
+		host, port := splitRedisAddress(address)
+		if "" == host {
+			return nil, ErrRedisHostIsEmpty
+		}
+		if "" == port {
+			return nil, ErrRedisPortIsEmpty
+		}
+	}
+
+	return addresses, nil
+}
+
+//
+// validateRedisAuthorizationClause returns the password of the authorization clause.
+// The user name is rejected because the redis client authenticates with the password only.
+//
+func validateRedisAuthorizationClause(url *url.URL) (string, error) {
+	if nil == url.User {
+		return "", nil
+	}
+	if "" != url.User.Username() {
+		return "", ErrRedisUserIsNotSupported
+	}
+	password, _ := url.User.Password()
+
+	return password, nil
+}
+
+//
+// validateRedisDBClause validates the database index clause.
+//
+func validateRedisDBClause(url *url.URL) (int, error) {
+	db := strings.Trim(url.Path, "/")
+	if "" == db {
+		return 0, nil
+	}
+
+	index, err := strconv.Atoi(db)
+	if nil != err || index < 0 {
+		return 0, errors.WithMessage(ErrRedisDatabaseIsIncorrect, `[database (%s)]`, db)
+	}
+
+	return index, nil
+}
+
+//
+// splitRedisAddress returns the host and the port of the address.
+//
+func splitRedisAddress(address string) (string, string) {
+	i := strings.LastIndex(address, ":")
+	if -1 == i || strings.HasSuffix(address, "]") {
+		return strings.Trim(address, "[]"), ""
 	}
 
-	return host, nil
+	return strings.Trim(address[:i], "[]"), address[i+1:]
 }
 
 //
-// validateRedisPortClause validates the hosts clause.
+// parseRedisTimeoutOption parses the non-negative timeout option value.
 //
-func validateRedisPortClause(url *url.URL) (string, error) {
-	port := url.Port()
-	if "" == port {
-		return "", ErrRedisPortIsEmpty
+func parseRedisTimeoutOption(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if nil == err && timeout < 0 {
+		return 0, errors.New("timeout must not be negative")
 	}
 
-	return port, nil
+	return timeout, err
 }
@@ -0,0 +1,219 @@
+package cfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Configuration parameter type names.
+//
+const (
+	TypeString     = "string"
+	TypeBase64     = "base64"
+	TypeURL        = "url"
+	TypeCassandra  = "cassandra"
+	TypePostgres   = "postgres"
+	TypeRedis      = "redis"
+	TypeLog        = "log"
+	TypeConfigFile = "config-file"
+	TypeInt        = "int"
+	TypeBool       = "bool"
+	TypeDuration   = "duration"
+	TypeList       = "list"
+	TypeFeature    = "feature"
+	TypeCustom     = "custom"
+)
+
+//
+// ParameterReference describes a registered configuration parameter for the operators documentation.
+// Default values of the secret parameters are redacted.
+//
+type ParameterReference struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Default     string `json:"default,omitempty"`
+	Example     string `json:"example,omitempty"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+	Secret      bool   `json:"secret"`
+}
+
+//
+// GetReference returns the references of all registered parameters ordered by the parameter name.
+//
+func (c *Config) GetReference() []ParameterReference {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	references := make([]ParameterReference, 0, len(c.parameters))
+	for _, param := range c.getParameterNames() {
+		references = append(references, newParameterReference(c.parameters[param]))
+	}
+
+	return references
+}
+
+//
+// WriteHelp writes the command-line help for all registered parameters.
+// Parse writes it to the help output when the help is requested with the -h/-help flag, see Config.SetHelpOutput.
+//
+func (c *Config) WriteHelp(w io.Writer) error {
+	lines := []string{"Configuration parameters (set as environment variables or -NAME=value flags):"}
+	for _, reference := range c.GetReference() {
+		lines = append(lines, fmt.Sprintf("  -%s %s%s", reference.Name, reference.Type, getReferenceMarkers(reference)))
+		if "" != reference.Description {
+			lines = append(lines, "    \t"+reference.Description)
+		}
+		if "" != reference.Default {
+			lines = append(lines, "    \tdefault: "+reference.Default)
+		}
+		if "" != reference.Example {
+			lines = append(lines, "    \texample: "+reference.Example)
+		}
+	}
+
+	if _, err := io.WriteString(w, strings.Join(lines, "\n")+"\n"); nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.WriteHelp")
+	}
+
+	return nil
+}
+
+//
+// WriteMarkdownReference writes the Markdown table of all registered parameters.
+//
+func (c *Config) WriteMarkdownReference(w io.Writer) error {
+	lines := []string{
+		"| Name | Type | Default | Required | Secret | Description | Example |",
+		"| --- | --- | --- | --- | --- | --- | --- |",
+	}
+	for _, reference := range c.GetReference() {
+		lines = append(lines, fmt.Sprintf(
+			"| `%s` | %s | %s | %s | %s | %s | %s |",
+			reference.Name,
+			reference.Type,
+			escapeMarkdownCell(reference.Default),
+			getMarkdownFlag(reference.Required),
+			getMarkdownFlag(reference.Secret),
+			escapeMarkdownCell(reference.Description),
+			escapeMarkdownCell(reference.Example),
+		))
+	}
+
+	if _, err := io.WriteString(w, strings.Join(lines, "\n")+"\n"); nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.WriteMarkdownReference")
+	}
+
+	return nil
+}
+
+//
+// WriteJSONReference writes the JSON array of all registered parameters.
+//
+func (c *Config) WriteJSONReference(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(c.GetReference()); nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.WriteJSONReference")
+	}
+
+	return nil
+}
+
+//
+// newParameterReference returns the parameter reference.
+//
+func newParameterReference(parameterEntry ParameterInfoProvider) ParameterReference {
+	defaultValue := parameterEntry.GetDefaultValue()
+	if parameterEntry.IsSecret() && "" != defaultValue {
+		defaultValue = RedactedValue
+	}
+
+	return ParameterReference{
+		Name:        parameterEntry.GetName(),
+		Type:        getParameterType(parameterEntry),
+		Default:     defaultValue,
+		Example:     parameterEntry.GetExample(),
+		Description: parameterEntry.GetDescription(),
+		Required:    !parameterEntry.IsOptional(),
+		Secret:      parameterEntry.IsSecret(),
+	}
+}
+
+//
+// getParameterType returns the parameter type name.
+//
+func getParameterType(parameterEntry ParameterInfoProvider) string {
+	switch parameterEntry.(type) {
+	case CustomParameter:
+		return TypeCustom
+	case *Base64StringInfo:
+		return TypeBase64
+	case *URLInfo:
+		return TypeURL
+	case *CassandraConnectionInfo:
+		return TypeCassandra
+	case *PostgresConnectionInfo:
+		return TypePostgres
+	case *RedisConnectionInfo:
+		return TypeRedis
+	case *LogInfo:
+		return TypeLog
+	case *ConfigFileInfo:
+		return TypeConfigFile
+	case *IntInfo:
+		return TypeInt
+	case *BoolInfo:
+		return TypeBool
+	case *DurationInfo:
+		return TypeDuration
+	case *ListInfo:
+		return TypeList
+	case *FeatureInfo:
+		return TypeFeature
+	}
+
+	return TypeString
+}
+
+//
+// getReferenceMarkers returns the help markers of the parameter.
+//
+func getReferenceMarkers(reference ParameterReference) string {
+	var markers []string
+	if reference.Required {
+		markers = append(markers, "required")
+	}
+	if reference.Secret {
+		markers = append(markers, "secret")
+	}
+	if 0 == len(markers) {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%s)", strings.Join(markers, ", "))
+}
+
+//
+// getMarkdownFlag returns the Markdown table cell for the boolean flag.
+//
+func getMarkdownFlag(flag bool) string {
+	if flag {
+		return "yes"
+	}
+
+	return "no"
+}
+
+//
+// escapeMarkdownCell escapes the Markdown table cell text.
+//
+func escapeMarkdownCell(text string) string {
+
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(text)
+}
@@ -0,0 +1,75 @@
+package cfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReference_WithRegisteredParameters_DescribesThem(t *testing.T) {
+	config := NewConfig()
+
+	config.RegisterURLParameter(TestParameter, Description("Service URL."), Example("https://host.com/v1"))
+	config.RegisterStringParameter(ProviderTestParameter, Secret(), Default("secret value"))
+	references := config.GetReference()
+
+	assert.Equal(t, []ParameterReference{
+		{Name: HTTPReadTimeout, Type: TypeDuration, Default: "1s"},
+		{Name: HTTPWriteTimeout, Type: TypeDuration, Default: "1s"},
+		{Name: string(ProviderTestParameter), Type: TypeString, Default: RedactedValue, Secret: true},
+		{
+			Name:        string(TestParameter),
+			Type:        TypeURL,
+			Example:     "https://host.com/v1",
+			Description: "Service URL.",
+			Required:    true,
+		},
+	}, references)
+}
+
+func TestWriteHelp_WithRegisteredParameters_WritesTheirDescriptions(t *testing.T) {
+	config := NewConfig()
+	var help bytes.Buffer
+
+	config.RegisterDurationParameter(TestParameter, Description("Request timeout."), Default("1s"))
+	err := config.WriteHelp(&help)
+
+	assert.Empty(t, err)
+	assert.Contains(t, help.String(), "-TEST_PARAMETER duration\n")
+	assert.Contains(t, help.String(), "Request timeout.")
+	assert.Contains(t, help.String(), "default: 1s")
+}
+
+func TestWriteMarkdownReference_WithRegisteredParameters_WritesATable(t *testing.T) {
+	config := NewConfig()
+	var reference bytes.Buffer
+
+	config.RegisterRedisParameter(TestParameter, Description("Cache | sessions."))
+	err := config.WriteMarkdownReference(&reference)
+	lines := strings.Split(strings.TrimSpace(reference.String()), "\n")
+
+	assert.Empty(t, err)
+	assert.Equal(t, 3+len(defaultParameters), len(lines))
+	assert.Equal(t, "| `TEST_PARAMETER` | redis |  | yes | no | Cache \\| sessions. |  |", lines[4])
+}
+
+func TestWriteJSONReference_WithRegisteredParameters_WritesAnArray(t *testing.T) {
+	config := NewConfig()
+	var reference bytes.Buffer
+	var references []ParameterReference
+
+	config.RegisterIntParameter(TestParameter, Optional())
+	err := config.WriteJSONReference(&reference)
+	errDecoding := json.Unmarshal(reference.Bytes(), &references)
+
+	assert.Empty(t, err)
+	assert.Empty(t, errDecoding)
+	assert.Equal(t, []ParameterReference{
+		{Name: HTTPReadTimeout, Type: TypeDuration, Default: "1s"},
+		{Name: HTTPWriteTimeout, Type: TypeDuration, Default: "1s"},
+		{Name: string(TestParameter), Type: TypeInt},
+	}, references)
+}
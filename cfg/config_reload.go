@@ -0,0 +1,239 @@
+package cfg
+
+import (
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/ameteiko/golang-kit/errors"
+	"github.com/ameteiko/golang-kit/log"
+)
+
+//
+// DefaultReloadInterval is a default configuration file change polling interval.
+//
+const DefaultReloadInterval = time.Second * 5
+
+//
+// ChangeListener is notified with the new parameter info after the parameter value was changed by a reload.
+//
+type ChangeListener func(ParameterInfoProvider)
+
+//
+// ReloadErrorHandler handles the failed background reloads. The previous configuration is kept on a failure.
+//
+type ReloadErrorHandler func(error)
+
+//
+// Subscribe subscribes the listener to the value changes of the parameters.
+//
+func (c *Config) Subscribe(listener ChangeListener, params ...Parameter) {
+	c.mu.Lock()
+	{
+		for _, param := range params {
+			c.listeners[param] = append(c.listeners[param], listener)
+		}
+	}
+	c.mu.Unlock()
+}
+
+//
+// Reload reloads the parameter values from the sources of the last parse.
+// The values are set to the fresh parameter entries and validated before the entries are published, so an invalid
+// configuration is rejected with a ValidationError and the current values are kept. The published entries are never
+// changed: the parameter infos returned before a reload keep the previous values, get them again to read the current
+// ones. Bound struct fields are set to the new entries and values when the entries are published, so they must not
+// be read concurrently with a reload; read them from a listener. The listeners of the changed parameters are notified
+// with the new entries after the publishing.
+//
+func (c *Config) Reload() error {
+	c.reloadMu.Lock()
+	defer c.reloadMu.Unlock()
+
+	if nil == c.parseProviders {
+		return errors.WithMessage(ErrConfigIsNotParsed, "kit-cfg@Config.Reload")
+	}
+
+	candidate := c.newReloadCandidate()
+	if err := candidate.provideParameters(c.parseProviders); nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.Reload")
+	}
+	if err := candidate.validateParameters(); nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.Reload")
+	}
+
+	notifications, err := c.swap(candidate)
+	if nil != err {
+		return errors.WithMessage(err, "kit-cfg@Config.Reload")
+	}
+	for _, notification := range notifications {
+		notification()
+	}
+
+	return nil
+}
+
+//
+// ReloadOnSignal reloads the configuration on every SIGHUP signal until the returned stop function is called.
+//
+func (c *Config) ReloadOnSignal(errorHandler ReloadErrorHandler) (stop func()) {
+	ch := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ch:
+				c.reload(errorHandler)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(ch)
+		close(done)
+	}
+}
+
+//
+// ReloadOnFileChange reloads the configuration when a file of the registered configuration file parameters is
+// changed. Files are polled with the interval until the returned stop function is called.
+//
+func (c *Config) ReloadOnFileChange(interval time.Duration, errorHandler ReloadErrorHandler) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	modTimes := c.getConfigFileModTimes()
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				currentModTimes := c.getConfigFileModTimes()
+				if !reflect.DeepEqual(modTimes, currentModTimes) {
+					modTimes = currentModTimes
+					c.reload(errorHandler)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+//
+// SubscribeLogSeverity sets the logger severity from the log parameter and updates it on every reload.
+//
+func (c *Config) SubscribeLogSeverity(param Parameter, logger *log.Log) error {
+	logInfo, err := c.GetLogParameter(param)
+	if nil != err {
+		return errors.WithMessage(err, `kit-cfg@Config.SubscribeLogSeverity [parameter (%s)]`, param)
+	}
+
+	logger.SetSeverity(logInfo.GetSeverity())
+	c.Subscribe(func(parameterEntry ParameterInfoProvider) {
+		if logInfo, ok := parameterEntry.(LogInfoProvider); ok {
+			logger.SetSeverity(logInfo.GetSeverity())
+		}
+	}, param)
+
+	return nil
+}
+
+//
+// reload reloads the configuration and reports the failure to the error handler.
+//
+func (c *Config) reload(errorHandler ReloadErrorHandler) {
+	if err := c.Reload(); nil != err && nil != errorHandler {
+		errorHandler(err)
+	}
+}
+
+//
+// newReloadCandidate returns a configuration with the fresh entries of the registered parameters for the reloading.
+// Entries are copied from the registration templates, so no value parsed before is left in them.
+//
+func (c *Config) newReloadCandidate() *Config {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	candidate := NewConfig()
+	for param, template := range c.templates {
+		candidate.parameters[param] = cloneParameterEntry(template)
+	}
+
+	return candidate
+}
+
+//
+// swap publishes the reloaded parameter entries, sets them to the bound struct fields and returns the notifications
+// of the listeners of the changed parameters. Nothing is published if the bound fields could not be set.
+//
+func (c *Config) swap(candidate *Config) ([]func(), error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.populateBindings(candidate.parameters); nil != err {
+		return nil, err
+	}
+
+	var notifications []func()
+	for param, reloadedEntry := range candidate.parameters {
+		if parameterEntry, ok := c.parameters[param]; ok && parameterEntry.GetValue() == reloadedEntry.GetValue() {
+			continue
+		}
+
+		for _, listener := range c.listeners[param] {
+			listener, reloadedEntry := listener, reloadedEntry
+			notifications = append(notifications, func() { listener(reloadedEntry) })
+		}
+	}
+	c.parameters = candidate.parameters
+	c.sources = candidate.sources
+
+	return notifications, nil
+}
+
+//
+// getConfigFileModTimes returns the modification times of the files of the registered configuration file parameters.
+//
+func (c *Config) getConfigFileModTimes() map[string]time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	modTimes := make(map[string]time.Time)
+	for _, parameterEntry := range c.parameters {
+		configFile, ok := parameterEntry.(ConfigFileInfoProvider)
+		if !ok || "" == configFile.GetPath() {
+			continue
+		}
+
+		if info, err := os.Stat(configFile.GetPath()); nil == err {
+			modTimes[configFile.GetPath()] = info.ModTime()
+		}
+	}
+
+	return modTimes
+}
+
+//
+// cloneParameterEntry returns a copy of the parameter entry with a copy of its base string parameter.
+//
+func cloneParameterEntry(parameterEntry ParameterInfoProvider) ParameterInfoProvider {
+	clone := reflect.New(reflect.TypeOf(parameterEntry).Elem())
+	clone.Elem().Set(reflect.ValueOf(parameterEntry).Elem())
+
+	stringParameter := *parameterEntry.getStringParameter()
+	if base := clone.Elem().FieldByName("StringParameter"); base.IsValid() && base.CanSet() {
+		base.Set(reflect.ValueOf(&stringParameter))
+	}
+
+	return clone.Interface().(ParameterInfoProvider)
+}
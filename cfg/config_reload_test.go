@@ -0,0 +1,192 @@
+package cfg
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/log"
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestReload_WithAChangedConfigFile_SwapsTheValuesAndNotifiesTheListeners(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": "https://host.com/v1"}`)
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+	var notified []string
+
+	config.Register(ConfigFile)
+	config.RegisterURLParameter(TestParameter)
+	errParsing := config.Parse()
+	url, _ := config.GetURLParameter(TestParameter)
+	config.Subscribe(func(p ParameterInfoProvider) { notified = append(notified, p.GetValue()) }, TestParameter)
+	ioutil.WriteFile(path, []byte(`{"TEST_PARAMETER": "https://reloaded.com/v1"}`), 0600)
+	errReloading := config.Reload()
+	reloadedURL, _ := config.GetURLParameter(TestParameter)
+
+	assert.Empty(t, errParsing)
+	assert.Empty(t, errReloading)
+	assert.Equal(t, "https://reloaded.com/v1", config.GetValue(TestParameter))
+	assert.Equal(t, "host.com", url.GetHost())
+	assert.Equal(t, "reloaded.com", reloadedURL.GetHost())
+	assert.Equal(t, []string{"https://reloaded.com/v1"}, notified)
+}
+
+func TestReload_WithAnOptionalValueRemoved_ResetsTheParsedValue(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": "42"}`)
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+
+	config.Register(ConfigFile)
+	config.RegisterIntParameter(TestParameter, Optional())
+	errParsing := config.Parse()
+	ioutil.WriteFile(path, []byte(`{}`), 0600)
+	errReloading := config.Reload()
+	intInfo, _ := config.GetIntParameter(TestParameter)
+
+	assert.Empty(t, errParsing)
+	assert.Empty(t, errReloading)
+	assert.Equal(t, "", intInfo.GetValue())
+	assert.Equal(t, 0, intInfo.GetInt())
+}
+
+func TestReload_WithConcurrentReads_PassesTheRaceDetector(t *testing.T) {
+	content := `{"TEST_PARAMETER": "https://host%d.com/v1", "CARDS_URL": "https://cards%d.com", ` +
+		`"HTTP_READ_TIMEOUT": "%ds"}`
+	path := writeConfigFile(t, "config.json", fmt.Sprintf(content, 0, 0, 1))
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	config.Register(ConfigFile)
+	config.Register(HTTPReadTimeout)
+	config.RegisterURLParameter(TestParameter)
+	config.RegisterFeature(FeatureTestName, "50%")
+	config.RegisterUpstream(UpstreamTestName, UpstreamVersion("v1"))
+	errParsing := config.Parse()
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if url, err := config.GetURLParameter(TestParameter); nil == err {
+					url.GetHost()
+				}
+				config.GetHTTPReadTimeout()
+				config.IsFeatureEnabledFor(FeatureTestName, "key")
+				if upstream, err := config.GetUpstream(UpstreamTestName); nil == err {
+					upstream.GetBaseURL()
+					upstream.GetTimeout()
+				}
+			}
+		}()
+	}
+	var errReloading error
+	for i := 0; i < 20 && nil == errReloading; i++ {
+		ioutil.WriteFile(path, []byte(fmt.Sprintf(content, i, i, i+1)), 0600)
+		errReloading = config.Reload()
+	}
+	close(done)
+	wg.Wait()
+
+	assert.Empty(t, errParsing)
+	assert.Empty(t, errReloading)
+	assert.Equal(t, 20*time.Second, config.GetHTTPReadTimeout())
+}
+
+func TestReload_WithBoundFields_UpdatesTheFields(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": "http://a.com", "PROVIDER_TEST_PARAMETER": "1"}`)
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+	target := struct {
+		URL     *URLInfo `cfg:"TEST_PARAMETER"`
+		Workers int      `cfg:"PROVIDER_TEST_PARAMETER"`
+	}{}
+
+	config.Register(ConfigFile)
+	errBind := config.Bind(&target)
+	errParsing := config.Parse()
+	ioutil.WriteFile(path, []byte(`{"TEST_PARAMETER": "http://b.com", "PROVIDER_TEST_PARAMETER": "2"}`), 0600)
+	errReloading := config.Reload()
+
+	assert.Empty(t, errBind)
+	assert.Empty(t, errParsing)
+	assert.Empty(t, errReloading)
+	assert.Equal(t, "b.com", target.URL.GetHost())
+	assert.Equal(t, 2, target.Workers)
+}
+
+func TestReload_WithAnInvalidValue_KeepsTheCurrentValues(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": "https://host.com/v1"}`)
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+	notified := false
+
+	config.Register(ConfigFile)
+	config.RegisterURLParameter(TestParameter)
+	errParsing := config.Parse()
+	config.Subscribe(func(p ParameterInfoProvider) { notified = true }, TestParameter)
+	ioutil.WriteFile(path, []byte(`{"TEST_PARAMETER": "*:?//"}`), 0600)
+	errReloading := config.Reload()
+
+	assert.Empty(t, errParsing)
+	helper.AssertError(t, ErrURLIncorrectValue, errReloading)
+	assert.Equal(t, "https://host.com/v1", config.GetValue(TestParameter))
+	assert.False(t, notified)
+}
+
+func TestReload_WithoutParsing_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+
+	err := config.Reload()
+
+	helper.AssertError(t, ErrConfigIsNotParsed, err)
+}
+
+func TestReloadOnFileChange_WithAChangedConfigFile_ReloadsTheLogSeverity(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "TEST_PARAMETER: INFO\n")
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+	logger := log.New(&bytes.Buffer{}, log.SeverityDebug)
+
+	config.Register(ConfigFile)
+	config.RegisterLogParameter(TestParameter)
+	errParsing := config.Parse()
+	errSubscribing := config.SubscribeLogSeverity(TestParameter, logger)
+	severity := logger.GetSeverity()
+	stop := config.ReloadOnFileChange(10*time.Millisecond, nil)
+	ioutil.WriteFile(path, []byte("TEST_PARAMETER: ERROR\n"), 0600)
+	os.Chtimes(path, time.Now().Add(time.Minute), time.Now().Add(time.Minute))
+	for i := 0; i < 100 && log.SeverityError != logger.GetSeverity(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	stop()
+
+	assert.Empty(t, errParsing)
+	assert.Empty(t, errSubscribing)
+	assert.Equal(t, log.SeverityInfo, severity)
+	assert.Equal(t, log.SeverityError, logger.GetSeverity())
+}
+
+func TestReload_WithAChangedFeatureFlag_UpdatesItsState(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"FEATURE_NEW_SEARCH": "false"}`)
+	config := NewConfig(NewMapProvider("map", map[string]string{ConfigFile: path}))
+
+	config.Register(ConfigFile)
+	config.RegisterFeature(FeatureTestName, "false")
+	errParsing := config.Parse()
+	enabledBefore := config.IsFeatureEnabled(FeatureTestName)
+	ioutil.WriteFile(path, []byte(`{"FEATURE_NEW_SEARCH": "100%"}`), 0600)
+	errReloading := config.Reload()
+
+	assert.Empty(t, errParsing)
+	assert.Empty(t, errReloading)
+	assert.False(t, enabledBefore)
+	assert.True(t, config.IsFeatureEnabled(FeatureTestName))
+}
@@ -0,0 +1,62 @@
+package cfg
+
+//
+// ParameterState describes the parsed state of a registered configuration parameter for the introspection.
+// Values of the secret parameters and URL passwords are redacted.
+//
+type ParameterState struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Source   string `json:"source,omitempty"`
+	Value    string `json:"value"`
+	Required bool   `json:"required"`
+	Secret   bool   `json:"secret"`
+	Valid    bool   `json:"valid"`
+	Error    string `json:"error,omitempty"`
+}
+
+//
+// GetParameterStates returns the states of all registered parameters ordered by the parameter name.
+// The validity is checked on the copies of the parameters, so the registered ones are not changed.
+//
+func (c *Config) GetParameterStates() []ParameterState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	states := make([]ParameterState, 0, len(c.parameters))
+	for _, param := range c.getParameterNames() {
+		parameterEntry := c.parameters[param]
+		state := ParameterState{
+			Name:     parameterEntry.GetName(),
+			Type:     getParameterType(parameterEntry),
+			Source:   c.sources[param],
+			Value:    parameterEntry.GetRedactedValue(),
+			Required: !parameterEntry.IsOptional(),
+			Secret:   parameterEntry.IsSecret(),
+			Valid:    true,
+		}
+		if err := validateParameterState(parameterEntry); nil != err {
+			state.Valid = false
+			state.Error = c.redact(err.Error())
+		}
+		states = append(states, state)
+	}
+
+	return states
+}
+
+//
+// validateParameterState validates a copy of the parameter entry. Empty optional parameters are valid.
+//
+func validateParameterState(parameterEntry ParameterInfoProvider) error {
+	if parameterEntry.IsOptional() && "" == parameterEntry.GetValue() {
+		return nil
+	}
+
+	clone := cloneParameterEntry(parameterEntry)
+	if err := clone.validate(); nil != err {
+		return err
+	}
+
+	return validateCustomParameter(clone)
+}
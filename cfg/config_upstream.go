@@ -0,0 +1,231 @@
+package cfg
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Upstream is a named upstream service, like "CARDS5" or "DEVPORTAL".
+//
+type Upstream string
+
+//
+// Upstream parameter name suffixes. The upstream parameters are named <UPSTREAM>_<SUFFIX>, e.g. CARDS5_URL.
+//
+const (
+	UpstreamURLSuffix     = "_URL"
+	UpstreamVersionSuffix = "_VERSION"
+	UpstreamTimeoutSuffix = "_TIMEOUT"
+	UpstreamAuthKeySuffix = "_AUTH_KEY"
+)
+
+//
+// Upstream defaults.
+//
+const (
+	DefaultUpstreamTimeout    = time.Second * 5
+	DefaultUpstreamAuthScheme = "Bearer"
+)
+
+//
+// UpstreamInfoProvider declares all the upstream getters.
+//
+type UpstreamInfoProvider interface {
+	GetName() string
+	GetURL() string
+	GetVersion() string
+	GetBaseURL() string
+	GetTimeout() time.Duration
+	GetAuthKey() string
+	GetAuthorization() string
+}
+
+//
+// UpstreamInfo is an upstream service info. Its values are kept by the upstream parameters:
+// <UPSTREAM>_URL is the required service URL validated like the URL parameter,
+// <UPSTREAM>_VERSION is the optional API version appended to the base URL,
+// <UPSTREAM>_TIMEOUT is the request timeout,
+// <UPSTREAM>_AUTH_KEY is the secret key sent in the Authorization header.
+// The values are read from the configuration on every call, so they follow the reloads.
+//
+type UpstreamInfo struct {
+	name            Upstream
+	defaultVersion  string
+	defaultTimeout  time.Duration
+	authScheme      string
+	authKeyRequired bool
+	config          *Config
+}
+
+//
+// UpstreamOption is an upstream registration option.
+//
+type UpstreamOption func(*UpstreamInfo)
+
+//
+// UpstreamVersion sets the default API version of the upstream.
+//
+func UpstreamVersion(version string) UpstreamOption {
+
+	return func(u *UpstreamInfo) {
+		u.defaultVersion = version
+	}
+}
+
+//
+// UpstreamTimeout sets the default request timeout of the upstream.
+//
+func UpstreamTimeout(timeout time.Duration) UpstreamOption {
+
+	return func(u *UpstreamInfo) {
+		u.defaultTimeout = timeout
+	}
+}
+
+//
+// UpstreamAuthScheme sets the Authorization header scheme of the upstream auth key.
+//
+func UpstreamAuthScheme(scheme string) UpstreamOption {
+
+	return func(u *UpstreamInfo) {
+		u.authScheme = scheme
+	}
+}
+
+//
+// UpstreamAuthKeyRequired marks the upstream auth key as required.
+//
+func UpstreamAuthKeyRequired() UpstreamOption {
+
+	return func(u *UpstreamInfo) {
+		u.authKeyRequired = true
+	}
+}
+
+//
+// RegisterUpstream registers the upstream parameters for the application.
+//
+func (c *Config) RegisterUpstream(name Upstream, options ...UpstreamOption) {
+	upstream := &UpstreamInfo{
+		name:           name,
+		defaultTimeout: DefaultUpstreamTimeout,
+		authScheme:     DefaultUpstreamAuthScheme,
+		config:         c,
+	}
+	for _, option := range options {
+		option(upstream)
+	}
+
+	c.register(newURLParameter(name.getParameter(UpstreamURLSuffix)), nil)
+	c.register(
+		newStringParameter(name.getParameter(UpstreamVersionSuffix)),
+		[]ParameterOption{Optional(), Default(upstream.defaultVersion)},
+	)
+	c.register(newHTTPTimeoutParameter(name.getParameter(UpstreamTimeoutSuffix), upstream.defaultTimeout), nil)
+	authKeyOptions := []ParameterOption{Secret()}
+	if !upstream.authKeyRequired {
+		authKeyOptions = append(authKeyOptions, Optional())
+	}
+	c.register(newStringParameter(name.getParameter(UpstreamAuthKeySuffix)), authKeyOptions)
+
+	c.mu.Lock()
+	{
+		c.upstreams[name] = upstream
+	}
+	c.mu.Unlock()
+}
+
+//
+// GetUpstream returns the registered upstream info.
+//
+func (c *Config) GetUpstream(name Upstream) (UpstreamInfoProvider, error) {
+	c.mu.RLock()
+	upstream, ok := c.upstreams[name]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, errors.WithMessage(
+			errors.ErrGetMisregisteredConfigParameter,
+			`kit-cfg@Config.GetUpstream [upstream (%s)]`, name,
+		)
+	}
+
+	return upstream, nil
+}
+
+//
+// GetName returns the upstream name.
+//
+func (u *UpstreamInfo) GetName() string {
+
+	return string(u.name)
+}
+
+//
+// GetURL returns the upstream service URL.
+//
+func (u *UpstreamInfo) GetURL() string {
+
+	return u.config.GetValue(u.name.getParameter(UpstreamURLSuffix))
+}
+
+//
+// GetVersion returns the upstream API version or an empty string if it is not set.
+//
+func (u *UpstreamInfo) GetVersion() string {
+
+	return u.config.GetValue(u.name.getParameter(UpstreamVersionSuffix))
+}
+
+//
+// GetBaseURL returns the upstream service URL with the API version appended.
+//
+func (u *UpstreamInfo) GetBaseURL() string {
+	baseURL := strings.TrimRight(u.GetURL(), "/")
+	if "" == u.GetVersion() {
+		return baseURL
+	}
+
+	return baseURL + "/" + strings.Trim(u.GetVersion(), "/")
+}
+
+//
+// GetTimeout returns the upstream request timeout.
+//
+func (u *UpstreamInfo) GetTimeout() time.Duration {
+	timeout, ok := u.config.getParameterEntry(u.name.getParameter(UpstreamTimeoutSuffix)).(DurationInfoProvider)
+	if !ok {
+		return u.defaultTimeout
+	}
+
+	return timeout.GetDuration()
+}
+
+//
+// GetAuthKey returns the upstream auth key.
+//
+func (u *UpstreamInfo) GetAuthKey() string {
+
+	return u.config.GetValue(u.name.getParameter(UpstreamAuthKeySuffix))
+}
+
+//
+// GetAuthorization returns the Authorization header value or an empty string if the auth key is not set.
+//
+func (u *UpstreamInfo) GetAuthorization() string {
+	if "" == u.GetAuthKey() {
+		return ""
+	}
+
+	return u.authScheme + " " + u.GetAuthKey()
+}
+
+//
+// getParameter returns the upstream parameter name with the suffix.
+//
+func (u Upstream) getParameter(suffix string) Parameter {
+
+	return Parameter(strings.ToUpper(string(u)) + suffix)
+}
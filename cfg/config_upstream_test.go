@@ -0,0 +1,92 @@
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/errors"
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+const (
+	UpstreamTestName Upstream = "cards"
+)
+
+func TestRegisterUpstream_WithAllValues_ReturnsTheUpstream(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		"CARDS_URL":      "https://cards.host.com/",
+		"CARDS_VERSION":  "v5",
+		"CARDS_TIMEOUT":  "10s",
+		"CARDS_AUTH_KEY": "key",
+	}))
+
+	config.RegisterUpstream(UpstreamTestName, UpstreamAuthScheme("VIRGIL"))
+	err := config.Parse()
+	upstream, errGetting := config.GetUpstream(UpstreamTestName)
+
+	assert.Empty(t, err)
+	assert.Empty(t, errGetting)
+	assert.Equal(t, "cards", upstream.GetName())
+	assert.Equal(t, "https://cards.host.com/v5", upstream.GetBaseURL())
+	assert.Equal(t, time.Second*10, upstream.GetTimeout())
+	assert.Equal(t, "VIRGIL key", upstream.GetAuthorization())
+	assert.Equal(t, RedactedValue, config.GetRedactedValue("CARDS_AUTH_KEY"))
+}
+
+func TestRegisterUpstream_WithTheURLOnly_UsesTheDefaults(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"CARDS_URL": "https://cards.host.com"}))
+
+	config.RegisterUpstream(UpstreamTestName, UpstreamVersion("v4"), UpstreamTimeout(time.Second))
+	err := config.Parse()
+	upstream, _ := config.GetUpstream(UpstreamTestName)
+
+	assert.Empty(t, err)
+	assert.Equal(t, "https://cards.host.com/v4", upstream.GetBaseURL())
+	assert.Equal(t, time.Second, upstream.GetTimeout())
+	assert.Empty(t, upstream.GetAuthorization())
+}
+
+func TestRegisterUpstream_WithAnIncorrectURL_ReturnsAnError(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"CARDS_URL": "*:?//"}))
+
+	config.RegisterUpstream(UpstreamTestName)
+	err := config.Parse()
+
+	helper.AssertError(t, ErrURLIncorrectValue, err)
+}
+
+func TestRegisterUpstream_WithARequiredAuthKeyNotSet_ReturnsAnError(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{"CARDS_URL": "https://cards.host.com"}))
+
+	config.RegisterUpstream(UpstreamTestName, UpstreamAuthKeyRequired())
+	err := config.Parse()
+
+	helper.AssertError(t, ErrConfigParameterIsEmpty, err)
+}
+
+func TestGetUpstream_WithoutRegistration_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+
+	_, err := config.GetUpstream(UpstreamTestName)
+
+	helper.AssertError(t, errors.ErrGetMisregisteredConfigParameter, err)
+}
+
+func TestRegisterUpstream_WithConcurrentLookups_PassesTheRaceDetector(t *testing.T) {
+	config := NewConfig()
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			config.GetUpstream(UpstreamTestName)
+		}
+	}()
+	config.RegisterUpstream(UpstreamTestName)
+	<-done
+	_, err := config.GetUpstream(UpstreamTestName)
+
+	assert.Empty(t, err)
+}
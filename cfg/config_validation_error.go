@@ -0,0 +1,140 @@
+package cfg
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// validationErrorProvider is an interface for the aggregated validation error lookup in the error chain.
+//
+type validationErrorProvider interface {
+	error
+
+	GetErrors() []ParameterError
+}
+
+//
+// ParameterError is a validation error of a single configuration parameter.
+//
+type ParameterError struct {
+	Parameter Parameter
+	Err       error
+}
+
+//
+// ValidationError is an aggregated validation error for all invalid configuration parameters.
+// Parameter errors are ordered by the parameter name.
+//
+type ValidationError struct {
+	errors []ParameterError
+}
+
+//
+// newValidationError returns a new aggregated validation error instance.
+//
+func newValidationError() *ValidationError {
+
+	return &ValidationError{}
+}
+
+//
+// GetValidationError returns the aggregated validation error from the error chain returned by Config.Parse.
+//
+func GetValidationError(err error) (*ValidationError, bool) {
+	validationErr, ok := errors.Cause(err, (*validationErrorProvider)(nil)).(*ValidationError)
+
+	return validationErr, ok
+}
+
+//
+// GetErrors returns all parameter validation errors.
+//
+func (e *ValidationError) GetErrors() []ParameterError {
+
+	return e.errors
+}
+
+//
+// GetParameterError returns the parameter validation error or nil if the parameter is valid.
+//
+func (e *ValidationError) GetParameterError(param Parameter) error {
+	for _, paramErr := range e.errors {
+		if param == paramErr.Parameter {
+			return paramErr.Err
+		}
+	}
+
+	return nil
+}
+
+//
+// Cause returns the first parameter validation error.
+// It keeps the error cause chain inspection working for the aggregated error.
+//
+func (e *ValidationError) Cause() error {
+	if 0 == len(e.errors) {
+		return nil
+	}
+
+	return e.errors[0].Err
+}
+
+//
+// Error returns the list of all parameter validation errors.
+//
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.errors))
+	for _, paramErr := range e.errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", paramErr.Parameter, paramErr.Err.Error()))
+	}
+
+	return fmt.Sprintf("%s\n%s", e.getHeader(), strings.Join(messages, "\n"))
+}
+
+//
+// Format formats the error. The %+v verb prints each parameter error with its details.
+//
+func (e *ValidationError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, e.getHeader())
+			for _, paramErr := range e.errors {
+				fmt.Fprintf(s, "\n%s: %+v", paramErr.Parameter, paramErr.Err)
+			}
+			return
+		}
+		fallthrough
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
+//
+// add adds a parameter validation error.
+//
+func (e *ValidationError) add(param Parameter, err error) {
+	e.errors = append(e.errors, ParameterError{Parameter: param, Err: err})
+}
+
+//
+// isEmpty returns true if there are no parameter validation errors.
+//
+func (e *ValidationError) isEmpty() bool {
+
+	return 0 == len(e.errors)
+}
+
+//
+// getHeader returns the error message header.
+//
+func (e *ValidationError) getHeader() string {
+
+	return fmt.Sprintf("configuration parameters validation failed for (%d) parameters:", len(e.errors))
+}
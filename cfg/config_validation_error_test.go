@@ -0,0 +1,72 @@
+package cfg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+//
+// Testing validation parameters.
+//
+const (
+	ValidationTestRedisParameter Parameter = "VALIDATION_TEST_REDIS"
+	ValidationTestURLParameter   Parameter = "VALIDATION_TEST_URL"
+	ValidationTestLogParameter   Parameter = "VALIDATION_TEST_LOG"
+)
+
+func TestParse_WithSeveralInvalidParameters_ReturnsAllValidationErrors(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		string(ValidationTestRedisParameter): "redis://host.com",
+		string(ValidationTestURLParameter):   "*:?//",
+		string(ValidationTestLogParameter):   "DEBUG",
+	}))
+
+	config.RegisterRedisParameter(ValidationTestRedisParameter)
+	config.RegisterURLParameter(ValidationTestURLParameter)
+	config.RegisterLogParameter(ValidationTestLogParameter)
+	config.RegisterStringParameter(TestParameter)
+	err := config.Parse()
+	validationErr, ok := GetValidationError(err)
+
+	assert.True(t, ok)
+	assert.Equal(t, 3, len(validationErr.GetErrors()))
+	assert.Equal(t, TestParameter, validationErr.GetErrors()[0].Parameter)
+	helper.AssertError(t, ErrConfigParameterIsEmpty, validationErr.GetErrors()[0].Err)
+	assert.Equal(t, ValidationTestRedisParameter, validationErr.GetErrors()[1].Parameter)
+	helper.AssertError(t, ErrRedisPortIsEmpty, validationErr.GetErrors()[1].Err)
+	assert.Equal(t, ValidationTestURLParameter, validationErr.GetErrors()[2].Parameter)
+	helper.AssertError(t, ErrURLIncorrectValue, validationErr.GetErrors()[2].Err)
+	assert.Nil(t, validationErr.GetParameterError(ValidationTestLogParameter))
+}
+
+func TestParse_WithAnInvalidParameter_ReturnsAnErrorWithTheParameterCause(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{}))
+
+	config.RegisterStringParameter(TestParameter)
+	err := config.Parse()
+
+	helper.AssertError(t, ErrConfigParameterIsEmpty, err)
+}
+
+func TestValidationError_WithSeveralErrors_ListsEveryParameter(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{}))
+
+	config.RegisterStringParameter(TestParameter)
+	config.RegisterStringParameter(ProviderTestParameter)
+	err := config.Parse()
+	validationErr, _ := GetValidationError(err)
+	lines := strings.Split(validationErr.Error(), "\n")
+	detailedMessage := fmt.Sprintf("%+v", validationErr)
+
+	assert.Equal(t, 3, len(lines))
+	assert.True(t, strings.HasPrefix(lines[1], string(ProviderTestParameter)+":"))
+	assert.True(t, strings.HasPrefix(lines[2], string(TestParameter)+":"))
+	assert.Contains(t, lines[2], ErrConfigParameterIsEmpty.Error())
+	assert.Contains(t, detailedMessage, string(ProviderTestParameter))
+	assert.Contains(t, detailedMessage, string(TestParameter))
+}
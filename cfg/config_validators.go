@@ -2,10 +2,64 @@ package cfg
 
 import (
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/ameteiko/errors"
 )
 
+//
+// parseURL parses the URL value.
+// The returned error doesn't contain the value itself because it might hold a password.
+//
+func parseURL(value string) (*url.URL, error) {
+	urlInfo, err := url.Parse(value)
+	if urlErr, ok := err.(*url.Error); ok {
+		return nil, errors.Errorf(`%s: %s`, urlErr.Op, urlErr.Err)
+	}
+
+	return urlInfo, err
+}
+
+//
+// parseInt parses the integer value.
+// The returned error doesn't contain the value itself because it might be a secret.
+//
+func parseInt(value string) (int, error) {
+	intValue, err := strconv.Atoi(value)
+	if numErr, ok := err.(*strconv.NumError); ok {
+		return 0, errors.Errorf(`%s: %s`, numErr.Func, numErr.Err)
+	}
+
+	return intValue, err
+}
+
+//
+// parseBool parses the boolean value.
+// The returned error doesn't contain the value itself because it might be a secret.
+//
+func parseBool(value string) (bool, error) {
+	boolValue, err := strconv.ParseBool(value)
+	if numErr, ok := err.(*strconv.NumError); ok {
+		return false, errors.Errorf(`%s: %s`, numErr.Func, numErr.Err)
+	}
+
+	return boolValue, err
+}
+
+//
+// parseDuration parses the duration value.
+// The returned error doesn't contain the value itself because it might be a secret.
+//
+func parseDuration(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if nil != err {
+		return 0, errors.New("time: invalid duration")
+	}
+
+	return duration, nil
+}
+
 //
 // validateCassandraConnectionProtocolClause validates the connection protocol clause.
 //
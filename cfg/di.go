@@ -14,6 +14,8 @@ type DIContainer struct {
 	builder *di.Builder
 
 	ignoredDeps map[string]bool
+	graph       *dependencyGraph
+	lifecycle   *lifecycle
 }
 
 //
@@ -22,6 +24,12 @@ type DIContainer struct {
 type dependencyRegistrar func(ctx di.Context) (interface{}, error)
 type dependencyDisposer func(obj interface{})
 
+//
+// GroupDependencyRegistrar builds a dependency from the parameter group, so the same registrar is reused for every
+// group of the resource.
+//
+type GroupDependencyRegistrar func(ctx di.Context, group *ParameterGroup) (interface{}, error)
+
 //
 // NewDIContainer returns an instance of the DI DIContainer.
 //
@@ -29,19 +37,77 @@ func NewDIContainer() *DIContainer {
 	c := DIContainer{}
 	c.builder, _ = di.NewBuilder(di.App, di.Request)
 	c.ignoredDeps = make(map[string]bool)
+	c.graph = newDependencyGraph()
+	c.lifecycle = newLifecycle()
 
 	return &c
 }
 
 //
-// Build builds application dependencies.
+// Build validates the dependency graph and builds application dependencies.
+// It reports the dependencies on the unknown names, the dependency cycles and the application dependencies on the
+// request-scoped ones. The graph consists of the declared dependencies and the ones resolved by the built
+// dependencies so far. The EagerSingletons option builds all application dependencies to surface their build errors
+// and cycles at startup, the AfterBuild option calls back the built container.
 //
-func (c *DIContainer) Build() error {
+func (c *DIContainer) Build(options ...BuildOption) error {
+	buildOptions := &buildOptions{}
+	for _, option := range options {
+		option(buildOptions)
+	}
+
+	if err := c.validateGraph(); nil != err {
+		return errors.WithMessage(err, "kit@cfg.DIContainer:Build")
+	}
 	c.ctx = c.builder.Build()
 
+	if buildOptions.eagerSingletons {
+		if err := c.buildSingletons(); nil != err {
+			return errors.WithMessage(err, "kit@cfg.DIContainer:Build")
+		}
+	}
+	for _, callback := range buildOptions.afterBuild {
+		if err := callback(c); nil != err {
+			return errors.WithMessage(err, "kit@cfg.DIContainer:Build")
+		}
+	}
+
 	return nil
 }
 
+//
+// NewRequestContainer returns a request-scoped container for the built container.
+// It resolves the request-scoped dependencies and delegates the application ones to the parent container. The
+// request container must be disposed with Delete at the end of the request.
+//
+func (c *DIContainer) NewRequestContainer() (*DIContainer, error) {
+	if nil == c.ctx {
+		return nil, errors.WithMessage(ErrDIContainerIsNotBuilt, "kit@cfg.DIContainer:NewRequestContainer")
+	}
+
+	ctx, err := c.ctx.SubContext()
+	if nil != err {
+		return nil, errors.WithMessage(err, "kit@cfg.DIContainer:NewRequestContainer")
+	}
+
+	return &DIContainer{
+		ctx:         ctx,
+		builder:     c.builder,
+		ignoredDeps: c.ignoredDeps,
+		graph:       c.graph,
+		lifecycle:   c.lifecycle,
+	}, nil
+}
+
+//
+// Delete disposes all the dependencies built by the container except the ones disposed by Stop.
+//
+func (c *DIContainer) Delete() {
+	if nil != c.ctx {
+		c.ctx.Delete()
+	}
+}
+
 //
 // Get returns the dependency by its name.
 //
@@ -50,6 +116,34 @@ func (c *DIContainer) Get(name string) interface{} {
 	return c.ctx.Get(name)
 }
 
+//
+// SafeGet returns the dependency by its name or an error if the dependency is not registered or could not be built.
+// Unlike Resolve, it accepts the nil dependency.
+//
+func (c *DIContainer) SafeGet(name string) (interface{}, error) {
+	obj, err := c.resolve(name)
+	if nil != err {
+		return nil, errors.WithMessage(err, "kit@cfg.DIContainer:SafeGet")
+	}
+
+	return obj, nil
+}
+
+//
+// GetDependencyNames returns the names of the application dependencies in the alphabetical order.
+//
+func (c *DIContainer) GetDependencyNames() []string {
+	var names []string
+	definitions := c.builder.Definitions()
+	for _, name := range getDefinitionNames(definitions) {
+		if di.App == definitions[name].Scope {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
 //
 // SetDependency sets the dependency by its name.
 //
@@ -69,22 +163,73 @@ func (c *DIContainer) IsDependencySet(name string) bool {
 
 //
 // RegisterDependency registers a dependency in DI.
+// The names of the dependencies the registrar resolves are declared for the graph validation on Build.
 //
 func (c *DIContainer) RegisterDependency(
 	depName string,
 	registrar dependencyRegistrar,
 	disposer dependencyDisposer,
+	dependsOn ...string,
+) error {
+
+	return c.registerDependency(di.App, depName, registrar, disposer, dependsOn)
+}
+
+//
+// RegisterRequestDependency registers a request-scoped dependency in DI.
+// The dependency is built once per request container and disposed when the request container is deleted.
+//
+func (c *DIContainer) RegisterRequestDependency(
+	depName string,
+	registrar dependencyRegistrar,
+	disposer dependencyDisposer,
+	dependsOn ...string,
+) error {
+
+	return c.registerDependency(di.Request, depName, registrar, disposer, dependsOn)
+}
+
+//
+// registerDependency registers a dependency in DI for the scope.
+//
+func (c *DIContainer) registerDependency(
+	scope string,
+	depName string,
+	registrar dependencyRegistrar,
+	disposer dependencyDisposer,
+	dependsOn []string,
 ) error {
 
 	err := c.builder.AddDefinition(
 		di.Definition{
 			Name:  depName,
-			Build: registrar,
-			Close: disposer,
+			Scope: scope,
+			Build: c.graph.track(scope, depName, registrar),
+			Close: c.lifecycle.wrapDisposer(depName, disposer),
 		})
 	if nil != err {
 		return errors.WithMessage(err, `kit@cfg.DIContainer:RegisterDependency [error on dependency (%s) registration]`, depName)
 	}
+	c.graph.addEdges(depName, dependsOn...)
 
 	return nil
 }
+
+//
+// RegisterGroupDependency registers a dependency of the parameter group in DI.
+// The dependency name is prefixed with the group prefix, see ParameterGroup.GetDependencyName.
+//
+func (c *DIContainer) RegisterGroupDependency(
+	group *ParameterGroup,
+	depName string,
+	registrar GroupDependencyRegistrar,
+	disposer dependencyDisposer,
+	dependsOn ...string,
+) error {
+	registerGroupDependency := func(ctx di.Context) (interface{}, error) {
+
+		return registrar(ctx, group)
+	}
+
+	return c.RegisterDependency(group.GetDependencyName(depName), registerGroupDependency, disposer, dependsOn...)
+}
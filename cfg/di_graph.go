@@ -0,0 +1,450 @@
+package cfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sarulabs/di"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// BuildOption is a DIContainer build option.
+//
+type BuildOption func(*buildOptions)
+
+//
+// buildOptions keeps the DIContainer build options.
+//
+type buildOptions struct {
+	eagerSingletons bool
+	afterBuild      []func(c *DIContainer) error
+}
+
+//
+// EagerSingletons builds all application dependencies on Build.
+//
+func EagerSingletons() BuildOption {
+
+	return func(o *buildOptions) {
+		o.eagerSingletons = true
+	}
+}
+
+//
+// AfterBuild calls the callback with the built container, e.g. to register the dependencies somewhere else.
+// The callbacks are called in the order of the options after the dependencies are validated and built.
+//
+func AfterBuild(callback func(c *DIContainer) error) BuildOption {
+
+	return func(o *buildOptions) {
+		o.afterBuild = append(o.afterBuild, callback)
+	}
+}
+
+//
+// DependencyNode is a dependency graph node.
+//
+type DependencyNode struct {
+	Name  string `json:"name"`
+	Scope string `json:"scope"`
+}
+
+//
+// DependencyEdge is a dependency graph edge from the dependency to the one it depends on.
+//
+type DependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+//
+// DependencyGraph is a dependency graph of the container.
+//
+type DependencyGraph struct {
+	Nodes []DependencyNode `json:"nodes"`
+	Edges []DependencyEdge `json:"edges"`
+}
+
+//
+// dependencyGraph keeps the declared dependencies and the ones resolved by the built dependencies.
+//
+type dependencyGraph struct {
+	mu       sync.Mutex
+	edges    map[string]map[string]bool
+	eager    bool
+	building []string
+	built    []string
+}
+
+//
+// trackingContext is a DI context that records the dependencies resolved by the dependency being built.
+//
+type trackingContext struct {
+	name  string
+	graph *dependencyGraph
+
+	di.Context
+}
+
+//
+// GetGraph returns the dependency graph with the nodes and edges ordered by the name.
+//
+func (c *DIContainer) GetGraph() DependencyGraph {
+	definitions := c.builder.Definitions()
+	graph := DependencyGraph{Nodes: []DependencyNode{}, Edges: []DependencyEdge{}}
+	for _, name := range getDefinitionNames(definitions) {
+		graph.Nodes = append(graph.Nodes, DependencyNode{Name: name, Scope: definitions[name].Scope})
+	}
+
+	edges := c.graph.getEdges()
+	for _, from := range getSortedKeys(edges) {
+		for _, to := range edges[from] {
+			graph.Edges = append(graph.Edges, DependencyEdge{From: from, To: to})
+		}
+	}
+
+	return graph
+}
+
+//
+// WriteDOTGraph writes the dependency graph in the Graphviz DOT format.
+//
+func (c *DIContainer) WriteDOTGraph(w io.Writer) error {
+	graph := c.GetGraph()
+	lines := []string{"digraph dependencies {"}
+	for _, node := range graph.Nodes {
+		lines = append(lines, fmt.Sprintf("  %q [label=%q];", node.Name, node.Name+"\n("+node.Scope+")"))
+	}
+	for _, edge := range graph.Edges {
+		lines = append(lines, fmt.Sprintf("  %q -> %q;", edge.From, edge.To))
+	}
+	lines = append(lines, "}")
+
+	if _, err := io.WriteString(w, strings.Join(lines, "\n")+"\n"); nil != err {
+		return errors.WithMessage(err, "kit@cfg.DIContainer:WriteDOTGraph")
+	}
+
+	return nil
+}
+
+//
+// WriteJSONGraph writes the dependency graph in the JSON format.
+//
+func (c *DIContainer) WriteJSONGraph(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(c.GetGraph()); nil != err {
+		return errors.WithMessage(err, "kit@cfg.DIContainer:WriteJSONGraph")
+	}
+
+	return nil
+}
+
+//
+// validateGraph validates the dependency names, scopes and cycles of the graph.
+//
+func (c *DIContainer) validateGraph() error {
+	definitions := c.builder.Definitions()
+	edges := c.graph.getEdges()
+	for _, from := range getSortedKeys(edges) {
+		for _, to := range edges[from] {
+			definition, ok := definitions[to]
+			if !ok {
+				return errors.WithMessage(ErrDIDependencyIsUnknown, `[dependency (%s), depends on (%s)]`, from, to)
+			}
+			if di.App == definitions[from].Scope && di.Request == definition.Scope {
+				return errors.WithMessage(ErrDIDependencyScopeIsIncorrect, `[dependency (%s), depends on (%s)]`, from, to)
+			}
+		}
+	}
+
+	if cycle := findDependencyCycle(edges); nil != cycle {
+		return errors.WithMessage(ErrDIDependencyCycle, `[cycle (%s)]`, strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+//
+// buildSingletons builds all application dependencies.
+// A failed build is validated again with the resolved dependencies to report the cycle if any.
+//
+func (c *DIContainer) buildSingletons() error {
+	c.graph.setEager(true)
+	defer c.graph.setEager(false)
+
+	definitions := c.builder.Definitions()
+	for _, name := range getDefinitionNames(definitions) {
+		if di.App != definitions[name].Scope {
+			continue
+		}
+
+		if _, err := c.safeGet(name); nil != err {
+			if graphErr := c.validateGraph(); nil != graphErr {
+				return graphErr
+			}
+
+			return errors.WrapError(ErrDIDependencyBuildError, errors.WithMessage(err, `[dependency (%s)]`, name))
+		}
+	}
+
+	return nil
+}
+
+//
+// safeGet builds the dependency and returns it or the build error or the panic of the dependency registrar.
+//
+func (c *DIContainer) safeGet(name string) (obj interface{}, err error) {
+	defer func() {
+		if r := recover(); nil != r {
+			err = errors.New(fmt.Sprintf("%v", r))
+		}
+	}()
+
+	return c.ctx.SafeGet(name)
+}
+
+//
+// newDependencyGraph returns a new dependency graph instance.
+//
+func newDependencyGraph() *dependencyGraph {
+
+	return &dependencyGraph{edges: make(map[string]map[string]bool)}
+}
+
+//
+// track returns the registrar recording the dependencies resolved by the registrar and the application dependencies
+// build order.
+//
+func (g *dependencyGraph) track(scope, name string, registrar dependencyRegistrar) dependencyRegistrar {
+
+	return func(ctx di.Context) (interface{}, error) {
+		g.push(name)
+		defer g.pop()
+
+		obj, err := registrar(&trackingContext{name: name, graph: g, Context: ctx})
+		if nil == err && di.App == scope {
+			g.addBuilt(name)
+		}
+
+		return obj, err
+	}
+}
+
+//
+// addBuilt records the built application dependency. The dependencies are recorded after the ones they depend on.
+//
+func (g *dependencyGraph) addBuilt(name string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.built = append(g.built, name)
+}
+
+//
+// getBuilt returns the built application dependencies in the build order.
+//
+func (g *dependencyGraph) getBuilt() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]string{}, g.built...)
+}
+
+//
+// addEdges adds the edges from the dependency to the dependencies it depends on.
+//
+func (g *dependencyGraph) addEdges(from string, to ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if nil == g.edges[from] {
+		g.edges[from] = make(map[string]bool)
+	}
+	for _, name := range to {
+		g.edges[from][name] = true
+	}
+}
+
+//
+// getEdges returns the dependencies of every dependency ordered by the name.
+//
+func (g *dependencyGraph) getEdges() map[string][]string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	edges := make(map[string][]string)
+	for from, to := range g.edges {
+		for name := range to {
+			edges[from] = append(edges[from], name)
+		}
+		sort.Strings(edges[from])
+	}
+
+	return edges
+}
+
+//
+// setEager sets the eager build mode, when the dependencies are built one by one and a cycle is tracked.
+//
+func (g *dependencyGraph) setEager(eager bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.eager = eager
+	g.building = nil
+}
+
+//
+// push records the dependency being built in the eager build mode.
+//
+func (g *dependencyGraph) push(name string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.eager {
+		g.building = append(g.building, name)
+	}
+}
+
+//
+// pop removes the built dependency in the eager build mode.
+//
+func (g *dependencyGraph) pop() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.eager && 0 < len(g.building) {
+		g.building = g.building[:len(g.building)-1]
+	}
+}
+
+//
+// isBuilding returns true if the dependency is being built in the eager build mode.
+//
+func (g *dependencyGraph) isBuilding(name string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, building := range g.building {
+		if name == building {
+			return true
+		}
+	}
+
+	return false
+}
+
+//
+// SafeGet records the dependency and returns it. A dependency cycle is reported in the eager build mode.
+//
+func (c *trackingContext) SafeGet(name string) (interface{}, error) {
+	c.graph.addEdges(c.name, name)
+	if c.graph.isBuilding(name) {
+		return nil, errors.WithMessage(ErrDIDependencyCycle, `[dependency (%s), depends on (%s)]`, c.name, name)
+	}
+
+	return c.Context.SafeGet(name)
+}
+
+//
+// Get records the dependency and returns it. It panics if the dependency could not be built.
+//
+func (c *trackingContext) Get(name string) interface{} {
+	obj, err := c.SafeGet(name)
+	if nil != err {
+		panic(err)
+	}
+
+	return obj
+}
+
+//
+// Fill records the dependency and fills the destination with it.
+//
+func (c *trackingContext) Fill(name string, dst interface{}) error {
+	c.graph.addEdges(c.name, name)
+	if c.graph.isBuilding(name) {
+		return errors.WithMessage(ErrDIDependencyCycle, `[dependency (%s), depends on (%s)]`, c.name, name)
+	}
+
+	return c.Context.Fill(name, dst)
+}
+
+//
+// findDependencyCycle returns the first dependency cycle of the graph or nil if there is none.
+//
+func findDependencyCycle(edges map[string][]string) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := make(map[string]int)
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch states[name] {
+		case visiting:
+			for i, pathName := range path {
+				if name == pathName {
+					return append(append([]string{}, path[i:]...), name)
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		states[name] = visiting
+		path = append(path, name)
+		for _, dependency := range edges[name] {
+			if cycle := visit(dependency); nil != cycle {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		states[name] = visited
+
+		return nil
+	}
+
+	for _, name := range getSortedKeys(edges) {
+		if cycle := visit(name); nil != cycle {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+//
+// getDefinitionNames returns the definition names in the alphabetical order.
+//
+func getDefinitionNames(definitions map[string]di.Definition) []string {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//
+// getSortedKeys returns the map keys in the alphabetical order.
+//
+func getSortedKeys(edges map[string][]string) []string {
+	keys := make([]string, 0, len(edges))
+	for key := range edges {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
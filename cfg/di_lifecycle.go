@@ -0,0 +1,236 @@
+package cfg
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/sarulabs/di"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// LifecycleHook is a dependency lifecycle hook, like opening a connection pool on start or flushing a buffer on stop.
+// The hook must respect the context deadline.
+//
+type LifecycleHook func(ctx context.Context, obj interface{}) error
+
+//
+// lifecycle keeps the lifecycle hooks of the application dependencies and the disposed dependencies.
+//
+type lifecycle struct {
+	mu       sync.Mutex
+	onStart  map[string][]LifecycleHook
+	onStop   map[string][]LifecycleHook
+	disposed map[string]bool
+}
+
+//
+// OnStart registers the hook called by Start for the registered application dependency.
+//
+func (c *DIContainer) OnStart(depName string, hook LifecycleHook) error {
+	if err := c.validateLifecycleDependency(depName); nil != err {
+		return errors.WithMessage(err, "kit@cfg.DIContainer:OnStart")
+	}
+	c.lifecycle.addHook(c.lifecycle.onStart, depName, hook)
+
+	return nil
+}
+
+//
+// OnStop registers the hook called by Stop for the registered application dependency before it is disposed.
+//
+func (c *DIContainer) OnStop(depName string, hook LifecycleHook) error {
+	if err := c.validateLifecycleDependency(depName); nil != err {
+		return errors.WithMessage(err, "kit@cfg.DIContainer:OnStop")
+	}
+	c.lifecycle.addHook(c.lifecycle.onStop, depName, hook)
+
+	return nil
+}
+
+//
+// Start builds the application dependencies with the lifecycle hooks and calls their OnStart hooks in the dependency
+// order, so a dependency is started after the ones it depends on. It stops on the first error.
+//
+func (c *DIContainer) Start(ctx context.Context) error {
+	if nil == c.ctx {
+		return errors.WithMessage(ErrDIContainerIsNotBuilt, "kit@cfg.DIContainer:Start")
+	}
+
+	for _, name := range c.lifecycle.getHookedNames() {
+		if _, err := c.safeGet(name); nil != err {
+			return errors.WrapError(
+				ErrDIDependencyBuildError,
+				errors.WithMessage(err, `kit@cfg.DIContainer:Start [dependency (%s)]`, name),
+			)
+		}
+	}
+
+	for _, name := range c.graph.getBuilt() {
+		for _, hook := range c.lifecycle.getHooks(c.lifecycle.onStart, name) {
+			if err := c.runHook(ctx, name, hook); nil != err {
+				return errors.WithMessage(err, "kit@cfg.DIContainer:Start")
+			}
+		}
+	}
+
+	return nil
+}
+
+//
+// Stop calls the OnStop hooks and disposes the built application dependencies in the reverse dependency order, so a
+// dependency is stopped before the ones it depends on. A failed hook does not prevent the other dependencies from
+// stopping, the first error is returned. The dependencies left when the context is done are not stopped.
+//
+func (c *DIContainer) Stop(ctx context.Context) error {
+	if nil == c.ctx {
+		return errors.WithMessage(ErrDIContainerIsNotBuilt, "kit@cfg.DIContainer:Stop")
+	}
+
+	var stopErr error
+	definitions := c.builder.Definitions()
+	built := c.graph.getBuilt()
+	for i := len(built) - 1; i >= 0; i-- {
+		name := built[i]
+		if c.lifecycle.isDisposed(name) {
+			continue
+		}
+		if nil != ctx.Err() {
+			return errors.WrapError(
+				ErrDIShutdownDeadlineExceeded,
+				errors.WithMessage(ctx.Err(), `kit@cfg.DIContainer:Stop [dependency (%s)]`, name),
+			)
+		}
+
+		for _, hook := range c.lifecycle.getHooks(c.lifecycle.onStop, name) {
+			if err := c.runHook(ctx, name, hook); nil != err && nil == stopErr {
+				stopErr = errors.WithMessage(err, "kit@cfg.DIContainer:Stop")
+			}
+		}
+
+		if obj, err := c.ctx.SafeGet(name); nil == err && nil != definitions[name].Close {
+			definitions[name].Close(obj)
+		}
+		c.lifecycle.setDisposed(name)
+	}
+
+	return stopErr
+}
+
+//
+// validateLifecycleDependency validates the dependency to be a registered application dependency.
+//
+func (c *DIContainer) validateLifecycleDependency(depName string) error {
+	definition, ok := c.builder.Definitions()[depName]
+	if !ok || di.App != definition.Scope {
+		return errors.WithMessage(ErrDILifecycleDependencyIsIncorrect, `[dependency (%s)]`, depName)
+	}
+
+	return nil
+}
+
+//
+// runHook calls the lifecycle hook of the dependency.
+//
+func (c *DIContainer) runHook(ctx context.Context, name string, hook LifecycleHook) error {
+	obj, err := c.ctx.SafeGet(name)
+	if nil == err {
+		err = hook(ctx, obj)
+	}
+	if nil != err {
+		return errors.WrapError(ErrDILifecycleHookError, errors.WithMessage(err, `[dependency (%s)]`, name))
+	}
+
+	return nil
+}
+
+//
+// newLifecycle returns a new lifecycle instance.
+//
+func newLifecycle() *lifecycle {
+
+	return &lifecycle{
+		onStart:  make(map[string][]LifecycleHook),
+		onStop:   make(map[string][]LifecycleHook),
+		disposed: make(map[string]bool),
+	}
+}
+
+//
+// addHook adds the dependency hook to the hooks.
+//
+func (l *lifecycle) addHook(hooks map[string][]LifecycleHook, name string, hook LifecycleHook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	hooks[name] = append(hooks[name], hook)
+}
+
+//
+// getHooks returns the dependency hooks in the registration order.
+//
+func (l *lifecycle) getHooks(hooks map[string][]LifecycleHook, name string) []LifecycleHook {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]LifecycleHook{}, hooks[name]...)
+}
+
+//
+// getHookedNames returns the names of the dependencies with the lifecycle hooks in the alphabetical order.
+//
+func (l *lifecycle) getHookedNames() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	hooked := make(map[string]bool)
+	for _, hooks := range []map[string][]LifecycleHook{l.onStart, l.onStop} {
+		for name := range hooks {
+			hooked[name] = true
+		}
+	}
+	names := make([]string, 0, len(hooked))
+	for name := range hooked {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//
+// setDisposed marks the dependency as disposed.
+//
+func (l *lifecycle) setDisposed(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.disposed[name] = true
+}
+
+//
+// isDisposed returns true if the dependency was disposed by Stop.
+//
+func (l *lifecycle) isDisposed(name string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.disposed[name]
+}
+
+//
+// wrapDisposer returns the disposer skipping the dependency disposed by Stop, so it is not disposed twice on Delete.
+//
+func (l *lifecycle) wrapDisposer(name string, disposer dependencyDisposer) dependencyDisposer {
+	if nil == disposer {
+		return nil
+	}
+
+	return func(obj interface{}) {
+		if !l.isDisposed(name) {
+			disposer(obj)
+		}
+	}
+}
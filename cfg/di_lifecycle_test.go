@@ -0,0 +1,115 @@
+package cfg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/errors"
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func newLifecycleTestContainer(events *[]string) *DIContainer {
+	container := NewDIContainer()
+	for _, name := range []string{"db", "repository", "service"} {
+		name := name
+		deps := map[string][]string{"repository": {"db"}, "service": {"repository"}}[name]
+		container.RegisterDependency(
+			name,
+			newDependencyRegistrar(deps...),
+			func(obj interface{}) { *events = append(*events, "close "+name) },
+			deps...,
+		)
+		container.OnStart(name, func(ctx context.Context, obj interface{}) error {
+			*events = append(*events, "start "+name)
+
+			return nil
+		})
+		container.OnStop(name, func(ctx context.Context, obj interface{}) error {
+			*events = append(*events, "stop "+name)
+
+			return nil
+		})
+	}
+
+	return container
+}
+
+func TestStart_WithHooks_StartsTheDependenciesInTheDependencyOrder(t *testing.T) {
+	var events []string
+	container := newLifecycleTestContainer(&events)
+	container.Build()
+
+	err := container.Start(context.Background())
+
+	assert.Empty(t, err)
+	assert.Equal(t, []string{"start db", "start repository", "start service"}, events)
+}
+
+func TestStop_WithHooks_StopsTheDependenciesInTheReverseDependencyOrder(t *testing.T) {
+	var events []string
+	container := newLifecycleTestContainer(&events)
+	container.Build()
+	container.Start(context.Background())
+	events = nil
+
+	err := container.Stop(context.Background())
+	container.Delete()
+
+	assert.Empty(t, err)
+	assert.Equal(t, []string{
+		"stop service", "close service",
+		"stop repository", "close repository",
+		"stop db", "close db",
+	}, events)
+}
+
+func TestStop_WithAFailingHook_StopsTheOtherDependencies(t *testing.T) {
+	var events []string
+	container := newLifecycleTestContainer(&events)
+	container.OnStop("repository", func(ctx context.Context, obj interface{}) error {
+
+		return errors.New("flush error")
+	})
+	container.Build()
+	container.Start(context.Background())
+	events = nil
+
+	err := container.Stop(context.Background())
+
+	helper.AssertError(t, ErrDILifecycleHookError, err)
+	assert.Contains(t, events, "close db")
+}
+
+func TestStop_WithAnExceededDeadline_ReturnsAnError(t *testing.T) {
+	var events []string
+	container := newLifecycleTestContainer(&events)
+	container.Build()
+	container.Start(context.Background())
+	events = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := container.Stop(ctx)
+
+	helper.AssertError(t, ErrDIShutdownDeadlineExceeded, err)
+	assert.Empty(t, events)
+}
+
+func TestOnStart_WithARequestDependency_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+	container.RegisterRequestDependency("session", newDependencyRegistrar(), nil)
+
+	err := container.OnStart("session", func(ctx context.Context, obj interface{}) error { return nil })
+
+	helper.AssertError(t, ErrDILifecycleDependencyIsIncorrect, err)
+}
+
+func TestStart_WithANotBuiltContainer_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+
+	err := container.Start(context.Background())
+
+	helper.AssertError(t, ErrDIContainerIsNotBuilt, err)
+}
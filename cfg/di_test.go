@@ -0,0 +1,111 @@
+package cfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/sarulabs/di"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/errors"
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func newDependencyRegistrar(deps ...string) dependencyRegistrar {
+
+	return func(ctx di.Context) (interface{}, error) {
+		for _, dep := range deps {
+			ctx.Get(dep)
+		}
+
+		return "object", nil
+	}
+}
+
+func TestBuild_WithValidDependencies_BuildsThem(t *testing.T) {
+	container := NewDIContainer()
+
+	container.RegisterDependency("repository", newDependencyRegistrar("db"), nil, "db")
+	container.RegisterDependency("db", newDependencyRegistrar(), nil)
+	container.RegisterRequestDependency("session", newDependencyRegistrar("repository"), nil, "repository")
+	err := container.Build(EagerSingletons())
+
+	assert.Empty(t, err)
+	assert.Equal(t, "object", container.Get("repository"))
+}
+
+func TestBuild_WithAnUnknownDependency_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+
+	container.RegisterDependency("repository", newDependencyRegistrar("db"), nil, "db")
+	err := container.Build()
+
+	helper.AssertError(t, ErrDIDependencyIsUnknown, err)
+}
+
+func TestBuild_WithADeclaredCycle_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+
+	container.RegisterDependency("a", newDependencyRegistrar("b"), nil, "b")
+	container.RegisterDependency("b", newDependencyRegistrar("c"), nil, "c")
+	container.RegisterDependency("c", newDependencyRegistrar("a"), nil, "a")
+	err := container.Build()
+
+	helper.AssertError(t, ErrDIDependencyCycle, err)
+	assert.Contains(t, err.Error(), "a -> b -> c -> a")
+}
+
+func TestBuild_WithAnUndeclaredCycleAndEagerSingletons_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+
+	container.RegisterDependency("a", newDependencyRegistrar("b"), nil)
+	container.RegisterDependency("b", newDependencyRegistrar("a"), nil)
+	err := container.Build(EagerSingletons())
+
+	helper.AssertError(t, ErrDIDependencyCycle, err)
+}
+
+func TestBuild_WithAnApplicationDependencyOnARequestOne_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+
+	container.RegisterDependency("repository", newDependencyRegistrar("session"), nil, "session")
+	container.RegisterRequestDependency("session", newDependencyRegistrar(), nil)
+	err := container.Build()
+
+	helper.AssertError(t, ErrDIDependencyScopeIsIncorrect, err)
+}
+
+func TestBuild_WithAFailingSingletonAndEagerSingletons_ReturnsAnError(t *testing.T) {
+	container := NewDIContainer()
+
+	container.RegisterDependency("db", func(ctx di.Context) (interface{}, error) {
+
+		return nil, errors.New("connection refused")
+	}, nil)
+	errLazy := container.Build()
+	errEager := container.Build(EagerSingletons())
+
+	assert.Empty(t, errLazy)
+	helper.AssertError(t, ErrDIDependencyBuildError, errEager)
+}
+
+func TestWriteGraph_WithDependencies_ExportsThem(t *testing.T) {
+	container := NewDIContainer()
+	var dot, jsonGraph bytes.Buffer
+	var graph DependencyGraph
+
+	container.RegisterDependency("repository", newDependencyRegistrar("db"), nil, "db")
+	container.RegisterDependency("db", newDependencyRegistrar(), nil)
+	errDOT := container.WriteDOTGraph(&dot)
+	errJSON := container.WriteJSONGraph(&jsonGraph)
+	json.Unmarshal(jsonGraph.Bytes(), &graph)
+
+	assert.Empty(t, errDOT)
+	assert.Empty(t, errJSON)
+	assert.Contains(t, dot.String(), `"repository" -> "db";`)
+	assert.Equal(t, DependencyGraph{
+		Nodes: []DependencyNode{{Name: "db", Scope: di.App}, {Name: "repository", Scope: di.App}},
+		Edges: []DependencyEdge{{From: "repository", To: "db"}},
+	}, graph)
+}
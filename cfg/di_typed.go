@@ -0,0 +1,189 @@
+package cfg
+
+import (
+	"reflect"
+
+	"github.com/sarulabs/di"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// InjectTag is a struct field tag for the dependency injection.
+//
+const InjectTag = "di"
+
+//
+// TypedDependencyRegistrar builds a dependency of the type T.
+//
+type TypedDependencyRegistrar[T any] func(ctx di.Context) (T, error)
+
+//
+// TypeName returns the dependency name of the type T qualified with its package path, e.g.
+// *github.com/go-redis/redis.Client or github.com/ameteiko/golang-kit/log.Logger, so the same-named types of
+// different packages get different names.
+//
+func TypeName[T any]() string {
+
+	return getTypeName(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+//
+// RegisterType registers an application dependency by its type, see TypeName. The disposer may be nil.
+//
+func RegisterType[T any](
+	c *DIContainer,
+	registrar TypedDependencyRegistrar[T],
+	disposer func(obj T),
+	dependsOn ...string,
+) error {
+
+	return c.RegisterDependency(TypeName[T](), registrar.untyped(), newTypedDisposer(disposer), dependsOn...)
+}
+
+//
+// RegisterRequestType registers a request-scoped dependency by its type, see TypeName. The disposer may be nil.
+//
+func RegisterRequestType[T any](
+	c *DIContainer,
+	registrar TypedDependencyRegistrar[T],
+	disposer func(obj T),
+	dependsOn ...string,
+) error {
+
+	return c.RegisterRequestDependency(TypeName[T](), registrar.untyped(), newTypedDisposer(disposer), dependsOn...)
+}
+
+//
+// Resolve returns the dependency by its name as the type T.
+// It returns an error if the dependency is not registered, could not be built or is not of the type T.
+//
+func Resolve[T any](c *DIContainer, name string) (T, error) {
+	var typed T
+	obj, err := c.resolve(name)
+	if nil != err {
+		return typed, errors.WithMessage(err, "kit@cfg.Resolve")
+	}
+
+	typed, ok := obj.(T)
+	if !ok {
+		return typed, errors.WithMessage(
+			ErrDIDependencyTypeIsIncorrect,
+			`kit@cfg.Resolve [dependency (%s), type (%T), expected type (%s)]`, name, obj, TypeName[T](),
+		)
+	}
+
+	return typed, nil
+}
+
+//
+// ResolveType returns the dependency registered by the type T, see RegisterType.
+//
+func ResolveType[T any](c *DIContainer) (T, error) {
+
+	return Resolve[T](c, TypeName[T]())
+}
+
+//
+// Inject sets the tagged fields of the target struct to the dependencies.
+// The target must be a pointer to a struct. Fields are tagged as `di:"name"`, the empty name resolves the dependency
+// registered by the field type, see RegisterType. The dependency must be assignable to the field.
+//
+func (c *DIContainer) Inject(target interface{}) error {
+	errMsg := `kit@cfg.DIContainer:Inject [target (%T)]`
+
+	targetValue := reflect.ValueOf(target)
+	if reflect.Ptr != targetValue.Kind() || targetValue.IsNil() || reflect.Struct != targetValue.Elem().Kind() {
+		return errors.WithMessage(ErrDIInjectTargetIsIncorrect, errMsg, target)
+	}
+
+	structValue := targetValue.Elem()
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		fieldType := structType.Field(i)
+		name, ok := fieldType.Tag.Lookup(InjectTag)
+		if !ok {
+			continue
+		}
+		if "" != fieldType.PkgPath {
+			return errors.WithMessage(ErrDIInjectTagIsIncorrect, errMsg+` [field (%s)]`, target, fieldType.Name)
+		}
+		if "" == name {
+			name = getTypeName(fieldType.Type)
+		}
+
+		obj, err := c.resolve(name)
+		if nil != err {
+			return errors.WithMessage(err, errMsg+` [field (%s)]`, target, fieldType.Name)
+		}
+		if nil == obj || !reflect.TypeOf(obj).AssignableTo(fieldType.Type) {
+			return errors.WithMessage(
+				ErrDIDependencyTypeIsIncorrect,
+				errMsg+` [field (%s), dependency (%s), type (%T), expected type (%s)]`,
+				target, fieldType.Name, name, obj, fieldType.Type,
+			)
+		}
+		structValue.Field(i).Set(reflect.ValueOf(obj))
+	}
+
+	return nil
+}
+
+//
+// resolve returns the registered dependency by its name.
+//
+func (c *DIContainer) resolve(name string) (interface{}, error) {
+	if nil == c.ctx {
+		return nil, ErrDIContainerIsNotBuilt
+	}
+	if _, ok := c.builder.Definitions()[name]; !ok {
+		return nil, errors.WithMessage(ErrDIDependencyIsNotRegistered, `[dependency (%s)]`, name)
+	}
+
+	obj, err := c.safeGet(name)
+	if nil != err {
+		return nil, errors.WrapError(ErrDIDependencyBuildError, errors.WithMessage(err, `[dependency (%s)]`, name))
+	}
+
+	return obj, nil
+}
+
+//
+// getTypeName returns the type name qualified with the package path of the named type or of the pointer element type.
+//
+func getTypeName(t reflect.Type) string {
+	if reflect.Ptr == t.Kind() {
+		return "*" + getTypeName(t.Elem())
+	}
+	if "" == t.PkgPath() {
+		return t.String()
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
+
+//
+// untyped returns the registrar of the untyped dependency.
+//
+func (r TypedDependencyRegistrar[T]) untyped() dependencyRegistrar {
+
+	return func(ctx di.Context) (interface{}, error) {
+
+		return r(ctx)
+	}
+}
+
+//
+// newTypedDisposer returns the disposer of the untyped dependency.
+//
+func newTypedDisposer[T any](disposer func(obj T)) dependencyDisposer {
+	if nil == disposer {
+		return nil
+	}
+
+	return func(obj interface{}) {
+		if typed, ok := obj.(T); ok {
+			disposer(typed)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package cfg
+
+import (
+	"fmt"
+	htmlTemplate "html/template"
+	"testing"
+	textTemplate "text/template"
+
+	"github.com/sarulabs/di"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+type injectTestRepository struct {
+	name string
+}
+
+type injectTestHandler struct {
+	Repository *injectTestRepository `di:""`
+	Name       fmt.Stringer          `di:"name"`
+	Ignored    string
+}
+
+type injectTestName string
+
+func (n injectTestName) String() string {
+
+	return string(n)
+}
+
+func newTypedTestContainer() *DIContainer {
+	container := NewDIContainer()
+	RegisterType(container, func(ctx di.Context) (*injectTestRepository, error) {
+
+		return &injectTestRepository{name: "repository"}, nil
+	}, nil)
+	container.RegisterDependency("name", func(ctx di.Context) (interface{}, error) {
+
+		return injectTestName("handler"), nil
+	}, nil)
+	container.Build()
+
+	return container
+}
+
+func TestResolve_WithARegisteredType_ReturnsTheTypedDependency(t *testing.T) {
+	container := newTypedTestContainer()
+
+	repository, err := ResolveType[*injectTestRepository](container)
+
+	assert.Empty(t, err)
+	assert.Equal(t, "repository", repository.name)
+	assert.Equal(t, "*github.com/ameteiko/golang-kit/cfg.injectTestRepository", TypeName[*injectTestRepository]())
+}
+
+func TestResolve_WithAnInterfaceType_ReturnsTheDependency(t *testing.T) {
+	container := newTypedTestContainer()
+
+	name, err := Resolve[fmt.Stringer](container, "name")
+
+	assert.Empty(t, err)
+	assert.Equal(t, "handler", name.String())
+}
+
+func TestResolve_WithAWrongType_ReturnsAnError(t *testing.T) {
+	container := newTypedTestContainer()
+
+	_, err := Resolve[int](container, "name")
+
+	helper.AssertError(t, ErrDIDependencyTypeIsIncorrect, err)
+}
+
+func TestResolve_WithAMissingDependency_ReturnsAnError(t *testing.T) {
+	container := newTypedTestContainer()
+
+	_, err := Resolve[string](container, "unknown")
+
+	helper.AssertError(t, ErrDIDependencyIsNotRegistered, err)
+}
+
+func TestInject_WithTaggedFields_SetsTheDependencies(t *testing.T) {
+	container := newTypedTestContainer()
+	handler := injectTestHandler{}
+
+	err := container.Inject(&handler)
+
+	assert.Empty(t, err)
+	assert.Equal(t, "repository", handler.Repository.name)
+	assert.Equal(t, "handler", handler.Name.String())
+}
+
+func TestInject_WithAWrongFieldType_ReturnsAnError(t *testing.T) {
+	container := newTypedTestContainer()
+	handler := struct {
+		Name int `di:"name"`
+	}{}
+
+	err := container.Inject(&handler)
+
+	helper.AssertError(t, ErrDIDependencyTypeIsIncorrect, err)
+	assert.Contains(t, err.Error(), "Name")
+}
+
+func TestInject_WithAMissingDependency_ReturnsAnError(t *testing.T) {
+	container := newTypedTestContainer()
+	handler := struct {
+		Cache fmt.Stringer `di:"cache"`
+	}{}
+
+	err := container.Inject(&handler)
+
+	helper.AssertError(t, ErrDIDependencyIsNotRegistered, err)
+}
+
+func TestInject_WithANonPointerTarget_ReturnsAnError(t *testing.T) {
+	container := newTypedTestContainer()
+
+	err := container.Inject(injectTestHandler{})
+
+	helper.AssertError(t, ErrDIInjectTargetIsIncorrect, err)
+}
+
+func TestRegisterType_WithTheSameNamedTypesOfDifferentPackages_ResolvesEachType(t *testing.T) {
+	container := NewDIContainer()
+	textTmpl := textTemplate.New("text")
+	htmlTmpl := htmlTemplate.New("html")
+
+	errText := RegisterType(container, func(ctx di.Context) (*textTemplate.Template, error) {
+
+		return textTmpl, nil
+	}, nil)
+	errHTML := RegisterType(container, func(ctx di.Context) (*htmlTemplate.Template, error) {
+
+		return htmlTmpl, nil
+	}, nil)
+	container.Build()
+	resolvedText, errResolvingText := ResolveType[*textTemplate.Template](container)
+	resolvedHTML, errResolvingHTML := ResolveType[*htmlTemplate.Template](container)
+
+	assert.Empty(t, errText)
+	assert.Empty(t, errHTML)
+	assert.Empty(t, errResolvingText)
+	assert.Empty(t, errResolvingHTML)
+	assert.Equal(t, textTmpl, resolvedText)
+	assert.Equal(t, htmlTmpl, resolvedHTML)
+	assert.Equal(t, "*text/template.Template", TypeName[*textTemplate.Template]())
+}
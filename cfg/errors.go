@@ -8,7 +8,64 @@ import (
 // Configuration parameter validation errors.
 //
 var (
-	ErrConfigParameterIsEmpty = errors.NewError("requested configuration parameter value is empty")
+	ErrConfigParameterIsEmpty        = errors.NewError("requested configuration parameter value is empty")
+	ErrConfigParameterIsNotAllowed   = errors.NewError("configuration parameter value is not allowed")
+	ErrConfigParameterIsNotAnInteger = errors.NewError("configuration parameter value is not an integer")
+	ErrConfigParameterIsNotABoolean  = errors.NewError("configuration parameter value is not a boolean")
+	ErrConfigParameterIsNotADuration = errors.NewError("configuration parameter value is not a duration")
+	ErrConfigParameterIsOutOfRange   = errors.NewError("configuration parameter value is out of range")
+	ErrFeatureValueIsIncorrect       = errors.NewError("feature flag value is neither a boolean nor a percentage")
+)
+
+//
+// Configuration providers errors.
+//
+var (
+	ErrConfigFlagsAreIncorrect        = errors.NewError("command-line flags are incorrect")
+	ErrConfigHelpIsRequested          = errors.NewError("command-line help is requested")
+	ErrConfigIsNotParsed              = errors.NewError("configuration is not parsed yet")
+	ErrConfigFileFormatIsNotSupported = errors.NewError("configuration file format is not supported")
+	ErrConfigFileReadError            = errors.NewError("configuration file reading error")
+	ErrConfigFileIsIncorrect          = errors.NewError("configuration file is malformed")
+	ErrConfigFileUnknownParameter     = errors.NewError("configuration file contains unknown parameters")
+)
+
+//
+// Configuration values encryption errors.
+//
+var (
+	ErrConfigDecryptionKeyIsEmpty     = errors.NewError("configuration decryption private key is empty")
+	ErrConfigDecryptionKeyIsIncorrect = errors.NewError("configuration decryption private key is incorrect")
+	ErrConfigValueDecryptionError     = errors.NewError("configuration parameter value decryption error")
+	ErrConfigValueEncryptionError     = errors.NewError("configuration parameter value encryption error")
+)
+
+//
+// Dependency injection errors.
+//
+var (
+	ErrDIContainerIsNotBuilt            = errors.NewError("dependency injection container is not built")
+	ErrDIDependencyIsUnknown            = errors.NewError("dependency depends on an unknown dependency")
+	ErrDIDependencyScopeIsIncorrect     = errors.NewError("application dependency depends on a request dependency")
+	ErrDIDependencyCycle                = errors.NewError("dependency cycle is detected")
+	ErrDIDependencyBuildError           = errors.NewError("dependency build error")
+	ErrDILifecycleDependencyIsIncorrect = errors.NewError("lifecycle hook dependency is not an application dependency")
+	ErrDILifecycleHookError             = errors.NewError("dependency lifecycle hook error")
+	ErrDIShutdownDeadlineExceeded       = errors.NewError("dependency shutdown deadline is exceeded")
+	ErrDIDependencyIsNotRegistered      = errors.NewError("dependency is not registered")
+	ErrDIDependencyTypeIsIncorrect      = errors.NewError("dependency type is incorrect")
+	ErrDIInjectTargetIsIncorrect        = errors.NewError("injection target is not a pointer to a struct")
+	ErrDIInjectTagIsIncorrect           = errors.NewError("injection tag is set on an unexported field")
+)
+
+//
+// Configuration binding errors.
+//
+var (
+	ErrConfigBindTargetIsIncorrect        = errors.NewError("configuration binding target is not a pointer to a struct")
+	ErrConfigBindTagIsIncorrect           = errors.NewError("configuration binding tag is incorrect")
+	ErrConfigBindFieldTypeIsNotSupported  = errors.NewError("configuration binding field type is not supported")
+	ErrConfigBindParameterTypeIsIncorrect = errors.NewError("configuration binding parameter has another type")
 )
 
 //
@@ -21,6 +78,20 @@ var (
 	ErrCassandraHostsAreEmpty               = errors.NewError("cassandra hosts are empty")
 	ErrCassandraKeyspaceIsEmpty             = errors.NewError("cassandra cassandraKeyspace is empty")
 	ErrCassandraPasswordIsEmpty             = errors.NewError("cassandra password cannot be empty if username was set")
+	ErrCassandraOptionIsUnknown             = errors.NewError("cassandra connection option is unknown")
+	ErrCassandraOptionIsIncorrect           = errors.NewError("cassandra connection option value is incorrect")
+)
+
+//
+// PostgreSQL errors.
+//
+var (
+	ErrPostgresConnectionStringIsEmpty     = errors.NewError("postgres connection string is empty")
+	ErrPostgresConnectionStringIsIncorrect = errors.NewError("postgres connection string is incorrect")
+	ErrPostgresProtocolIsIncorrect         = errors.NewError("postgres connection protocol is incorrect")
+	ErrPostgresHostIsEmpty                 = errors.NewError("postgres host is empty")
+	ErrPostgresDatabaseIsEmpty             = errors.NewError("postgres database is empty")
+	ErrPostgresOptionIsIncorrect           = errors.NewError("postgres connection option value is incorrect")
 )
 
 //
@@ -32,6 +103,11 @@ var (
 	ErrRedisProtocolIsIncorrect         = errors.NewError("redis connection protocol is incorrect")
 	ErrRedisHostIsEmpty                 = errors.NewError("redis host is empty")
 	ErrRedisPortIsEmpty                 = errors.NewError("redis port is empty")
+	ErrRedisDatabaseIsIncorrect         = errors.NewError("redis database index is incorrect")
+	ErrRedisUserIsNotSupported          = errors.NewError("redis connection user is not supported")
+	ErrRedisOptionIsUnknown             = errors.NewError("redis connection option is unknown")
+	ErrRedisOptionIsIncorrect           = errors.NewError("redis connection option value is incorrect")
+	ErrRedisTopologyIsIncorrect         = errors.NewError("redis connection topology is incorrect")
 )
 
 //
@@ -1 +1,27 @@
 package cfg
+
+//
+// Configuration parameter value source names.
+//
+const (
+	SourceEnv     = "env"
+	SourceFlags   = "flags"
+	SourceDefault = "default"
+)
+
+//
+// Provider is a configuration parameter values source.
+// Config applies providers in the registration order, so the values of the later providers take precedence over the
+// values of the earlier ones.
+//
+type Provider interface {
+	//
+	// GetName returns the provider name. It is reported as the parameter value source.
+	//
+	GetName() string
+
+	//
+	// Provide returns the values for the passed parameters. Parameters without a value are omitted from the result.
+	//
+	Provide(params []Parameter) (map[Parameter]string, error)
+}
@@ -1,5 +1,41 @@
 package cfg
 
-type Provider interface {
-	Provide() map[string]string
-}
\ No newline at end of file
+import (
+	"os"
+)
+
+//
+// EnvProvider is a provider for the configuration parameters set as environment variables.
+// The environment variable name is the parameter name.
+//
+type EnvProvider struct{}
+
+//
+// NewEnvProvider returns a new environment variables provider instance.
+//
+func NewEnvProvider() *EnvProvider {
+
+	return &EnvProvider{}
+}
+
+//
+// GetName returns the provider name.
+//
+func (p *EnvProvider) GetName() string {
+
+	return SourceEnv
+}
+
+//
+// Provide returns the values of the environment variables named after the parameters.
+//
+func (p *EnvProvider) Provide(params []Parameter) (map[Parameter]string, error) {
+	values := make(map[Parameter]string)
+	for _, param := range params {
+		if value, ok := os.LookupEnv(string(param)); ok {
+			values[param] = value
+		}
+	}
+
+	return values, nil
+}
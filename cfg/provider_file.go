@@ -0,0 +1,253 @@
+package cfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/subosito/gotenv"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Configuration file formats.
+//
+const (
+	FileFormatJSON   = "json"
+	FileFormatYAML   = "yaml"
+	FileFormatDotEnv = "dotenv"
+)
+
+//
+// SourceFile is a source name prefix for the configuration file providers.
+//
+const SourceFile = "file"
+
+//
+// fileParser parses the configuration file contents into the key/value pairs.
+//
+type fileParser func(contents []byte) (map[string]interface{}, error)
+
+//
+// fileParsers lists the parsers for all supported configuration file formats.
+//
+var fileParsers = map[string]fileParser{
+	FileFormatJSON:   parseJSONFile,
+	FileFormatYAML:   parseYAMLFile,
+	FileFormatDotEnv: parseDotEnvFile,
+}
+
+//
+// FileProvider is a provider for the configuration parameters stored in a JSON, YAML or dotenv file.
+// File keys are the parameter names. Keys that don't match any registered parameter are reported as an error.
+//
+type FileProvider struct {
+	path   string
+	format string
+}
+
+//
+// NewFileProvider returns a new configuration file provider instance.
+// The file format is detected by the file extension: .json, .yaml, .yml or .env.
+//
+func NewFileProvider(path string) *FileProvider {
+
+	return &FileProvider{path: path, format: getFileFormat(path)}
+}
+
+//
+// NewJSONFileProvider returns a new JSON configuration file provider instance.
+//
+func NewJSONFileProvider(path string) *FileProvider {
+
+	return &FileProvider{path: path, format: FileFormatJSON}
+}
+
+//
+// NewYAMLFileProvider returns a new YAML configuration file provider instance.
+//
+func NewYAMLFileProvider(path string) *FileProvider {
+
+	return &FileProvider{path: path, format: FileFormatYAML}
+}
+
+//
+// NewDotEnvFileProvider returns a new dotenv configuration file provider instance.
+//
+func NewDotEnvFileProvider(path string) *FileProvider {
+
+	return &FileProvider{path: path, format: FileFormatDotEnv}
+}
+
+//
+// GetName returns the provider name.
+//
+func (p *FileProvider) GetName() string {
+
+	return fmt.Sprintf("%s:%s", SourceFile, p.path)
+}
+
+//
+// GetPath returns the configuration file path.
+//
+func (p *FileProvider) GetPath() string {
+
+	return p.path
+}
+
+//
+// GetFormat returns the configuration file format.
+//
+func (p *FileProvider) GetFormat() string {
+
+	return p.format
+}
+
+//
+// Provide returns the configuration file values for the passed parameters.
+//
+func (p *FileProvider) Provide(params []Parameter) (map[Parameter]string, error) {
+	errMsg := `kit-cfg@FileProvider.Provide [file (%s), format (%s)]`
+
+	parse, ok := fileParsers[p.format]
+	if !ok {
+		return nil, errors.WithMessage(ErrConfigFileFormatIsNotSupported, errMsg, p.path, p.format)
+	}
+
+	contents, err := ioutil.ReadFile(p.path)
+	if nil != err {
+		return nil, errors.WrapError(ErrConfigFileReadError, errors.WithMessage(err, errMsg, p.path, p.format))
+	}
+
+	entries, err := parse(contents)
+	if nil != err {
+		return nil, errors.WrapError(ErrConfigFileIsIncorrect, errors.WithMessage(err, errMsg, p.path, p.format))
+	}
+
+	registered := make(map[string]bool, len(params))
+	for _, param := range params {
+		registered[string(param)] = true
+	}
+
+	var unknownKeys []string
+	values := make(map[Parameter]string)
+	for key, entry := range entries {
+		if !registered[key] {
+			unknownKeys = append(unknownKeys, key)
+			continue
+		}
+
+		value, err := getFileValue(entry)
+		if nil != err {
+			return nil, errors.WrapError(
+				ErrConfigFileIsIncorrect,
+				errors.WithMessage(err, `%s [key (%s)]`, errMsg, key),
+			)
+		}
+		values[Parameter(key)] = value
+	}
+
+	if 0 != len(unknownKeys) {
+		sort.Strings(unknownKeys)
+		return nil, errors.WithMessage(
+			ErrConfigFileUnknownParameter,
+			`%s [keys (%s)]`,
+			errMsg, strings.Join(unknownKeys, ", "),
+		)
+	}
+
+	return values, nil
+}
+
+//
+// getFileFormat returns the configuration file format by its extension.
+//
+func getFileFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FileFormatJSON
+	case ".yaml", ".yml":
+		return FileFormatYAML
+	case ".env":
+		return FileFormatDotEnv
+	}
+
+	return ""
+}
+
+//
+// getFileValue converts a scalar configuration file value into the parameter value.
+// Lists of scalars are converted into the comma separated list parameter values.
+//
+func getFileValue(entry interface{}) (string, error) {
+	switch value := entry.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case json.Number, bool, int, int64, uint64, float64:
+		return fmt.Sprint(value), nil
+	case []interface{}:
+		items := make([]string, 0, len(value))
+		for _, itemEntry := range value {
+			item, err := getFileValue(itemEntry)
+			if _, isList := itemEntry.([]interface{}); isList || nil != err {
+				return "", errors.New(fmt.Sprintf(`list item of type (%T) is not a scalar`, itemEntry))
+			}
+			items = append(items, item)
+		}
+
+		return strings.Join(items, ListSeparator), nil
+	}
+
+	return "", errors.New(fmt.Sprintf(`value of type (%T) is not a scalar`, entry))
+}
+
+//
+// parseJSONFile parses a JSON configuration file.
+//
+func parseJSONFile(contents []byte) (map[string]interface{}, error) {
+	entries := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.UseNumber()
+	if err := decoder.Decode(&entries); nil != err {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+//
+// parseYAMLFile parses a YAML configuration file.
+//
+func parseYAMLFile(contents []byte) (map[string]interface{}, error) {
+	entries := make(map[string]interface{})
+	if err := yaml.Unmarshal(contents, &entries); nil != err {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+//
+// parseDotEnvFile parses a dotenv configuration file.
+//
+func parseDotEnvFile(contents []byte) (map[string]interface{}, error) {
+	env, err := gotenv.StrictParse(bytes.NewReader(contents))
+	if nil != err {
+		return nil, err
+	}
+
+	entries := make(map[string]interface{}, len(env))
+	for key, value := range env {
+		entries[key] = value
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,141 @@
+package cfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+func TestFileProvider_WithAJSONFile_ReturnsItsValues(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": "json value", "PROVIDER_TEST_PARAMETER": 8080}`)
+	p := NewFileProvider(path)
+
+	values, err := p.Provide([]Parameter{TestParameter, ProviderTestParameter})
+
+	assert.Empty(t, err)
+	assert.Equal(t, map[Parameter]string{TestParameter: "json value", ProviderTestParameter: "8080"}, values)
+}
+
+func TestFileProvider_WithAYAMLFile_ReturnsItsValues(t *testing.T) {
+	path := writeConfigFile(t, "config.yml", "TEST_PARAMETER: yaml value\nPROVIDER_TEST_PARAMETER: true\n")
+	p := NewFileProvider(path)
+
+	values, err := p.Provide([]Parameter{TestParameter, ProviderTestParameter})
+
+	assert.Empty(t, err)
+	assert.Equal(t, map[Parameter]string{TestParameter: "yaml value", ProviderTestParameter: "true"}, values)
+}
+
+func TestFileProvider_WithADotEnvFile_ReturnsItsValues(t *testing.T) {
+	path := writeConfigFile(t, "config.env", "TEST_PARAMETER=\"dotenv value\"\n")
+	p := NewFileProvider(path)
+
+	values, err := p.Provide([]Parameter{TestParameter})
+
+	assert.Empty(t, err)
+	assert.Equal(t, map[Parameter]string{TestParameter: "dotenv value"}, values)
+}
+
+func TestFileProvider_WithAnUnknownKey_ReturnsAnError(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": "value", "UNKNOWN_PARAMETER": "value"}`)
+	p := NewFileProvider(path)
+
+	_, err := p.Provide([]Parameter{TestParameter})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFileUnknownParameter, err)
+}
+
+func TestFileProvider_WithAMalformedFile_ReturnsAnError(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"TEST_PARAMETER": `)
+	p := NewFileProvider(path)
+
+	_, err := p.Provide([]Parameter{TestParameter})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFileIsIncorrect, err)
+}
+
+func TestFileProvider_WithANestedValue_ReturnsAnError(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "TEST_PARAMETER:\n  nested: value\n")
+	p := NewFileProvider(path)
+
+	_, err := p.Provide([]Parameter{TestParameter})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFileIsIncorrect, err)
+}
+
+func TestFileProvider_WithAnUnsupportedFormat_ReturnsAnError(t *testing.T) {
+	path := writeConfigFile(t, "config.ini", "TEST_PARAMETER=value")
+	p := NewFileProvider(path)
+
+	_, err := p.Provide([]Parameter{TestParameter})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFileFormatIsNotSupported, err)
+}
+
+func TestFileProvider_WithAMissingFile_ReturnsAnError(t *testing.T) {
+	p := NewJSONFileProvider(filepath.Join(os.TempDir(), "missing-kit-config.json"))
+
+	_, err := p.Provide([]Parameter{TestParameter})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFileReadError, err)
+}
+
+func TestParse_WithAConfigFileParameter_TakesTheFileValuesWithTheLowestPrecedence(t *testing.T) {
+	path := writeConfigFile(
+		t,
+		"config.json",
+		`{"TEST_PARAMETER": "file value", "PROVIDER_TEST_PARAMETER": "file value"}`,
+	)
+	config := NewConfig(NewMapProvider("map", map[string]string{
+		ConfigFile:                    path,
+		string(ProviderTestParameter): "map value",
+	}))
+
+	config.Register(ConfigFile)
+	config.RegisterStringParameter(TestParameter)
+	config.RegisterStringParameter(ProviderTestParameter)
+	err := config.Parse()
+	configFile, errConfigFile := config.GetConfigFileParameter(ConfigFile)
+
+	assert.Empty(t, err)
+	assert.Empty(t, errConfigFile)
+	assert.Equal(t, FileFormatJSON, configFile.GetFormat())
+	assert.Equal(t, "file value", config.GetValue(TestParameter))
+	assert.Equal(t, "file:"+path, config.GetSource(TestParameter))
+	assert.Equal(t, "map value", config.GetValue(ProviderTestParameter))
+	assert.Equal(t, "map", config.GetSource(ProviderTestParameter))
+}
+
+func TestParse_WithAnEmptyConfigFileParameter_Passes(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{string(TestParameter): "map value"}))
+
+	config.Register(ConfigFile)
+	config.RegisterStringParameter(TestParameter)
+	err := config.Parse()
+
+	assert.Empty(t, err)
+	assert.Equal(t, "map value", config.GetValue(TestParameter))
+}
+
+//
+// writeConfigFile writes the configuration file into a temporary directory and returns its path.
+// The directory is removed when the test completes.
+//
+func writeConfigFile(t *testing.T, name, contents string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); nil != err {
+		t.Fatal(err)
+	}
+
+	return path
+}
@@ -0,0 +1,73 @@
+package cfg
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// FlagProvider is a provider for the configuration parameters passed as command-line flags.
+// The flag name is the parameter name, i.e. -DEVPORTAL_URL=https://...
+//
+type FlagProvider struct {
+	args []string
+}
+
+//
+// NewFlagProvider returns a new command-line flags provider instance for the arguments (without the program name).
+//
+func NewFlagProvider(args []string) *FlagProvider {
+
+	return &FlagProvider{args: args}
+}
+
+//
+// GetName returns the provider name.
+//
+func (p *FlagProvider) GetName() string {
+
+	return SourceFlags
+}
+
+//
+// Provide returns the values of the flags set in the command-line arguments.
+// Nothing is printed on a parsing failure: the -h/-help flag is reported as ErrConfigHelpIsRequested and the other
+// failures as ErrConfigFlagsAreIncorrect. The help is written by Config.Parse, see Config.WriteHelp.
+//
+func (p *FlagProvider) Provide(params []Parameter) (map[Parameter]string, error) {
+	flags := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	for _, param := range params {
+		flags.String(string(param), "", "")
+	}
+
+	err := flags.Parse(p.args)
+	if flag.ErrHelp == err {
+		return nil, errors.WithMessage(ErrConfigHelpIsRequested, `kit-cfg@FlagProvider.Provide`)
+	}
+	if nil != err {
+		return nil, errors.WrapError(
+			ErrConfigFlagsAreIncorrect,
+			errors.WithMessage(err, `kit-cfg@FlagProvider.Provide`),
+		)
+	}
+
+	values := make(map[Parameter]string)
+	flags.Visit(func(f *flag.Flag) {
+		values[Parameter(f.Name)] = f.Value.String()
+	})
+
+	return values, nil
+}
+
+//
+// isHelpRequested returns true if the error is caused by the -h/-help flag.
+//
+func isHelpRequested(err error) bool {
+	cause := errors.Cause(err, (*errors.ErrorInfoProvider)(nil))
+
+	return nil != cause && ErrConfigHelpIsRequested.Error() == cause.Error()
+}
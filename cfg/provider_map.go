@@ -0,0 +1,40 @@
+package cfg
+
+//
+// MapProvider is an in-memory configuration parameters provider.
+// It is handy for tests and for the values computed by the application itself.
+//
+type MapProvider struct {
+	name   string
+	values map[string]string
+}
+
+//
+// NewMapProvider returns a new in-memory provider instance.
+//
+func NewMapProvider(name string, values map[string]string) *MapProvider {
+
+	return &MapProvider{name: name, values: values}
+}
+
+//
+// GetName returns the provider name.
+//
+func (p *MapProvider) GetName() string {
+
+	return p.name
+}
+
+//
+// Provide returns the map values for the passed parameters.
+//
+func (p *MapProvider) Provide(params []Parameter) (map[Parameter]string, error) {
+	values := make(map[Parameter]string)
+	for _, param := range params {
+		if value, ok := p.values[string(param)]; ok {
+			values[param] = value
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,149 @@
+package cfg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/test/helper"
+)
+
+//
+// Testing providers constants.
+//
+const (
+	ProviderTestParameter Parameter = "PROVIDER_TEST_PARAMETER"
+)
+
+func TestEnvProvider_WithAnEnvironmentVariableSet_ReturnsItsValue(t *testing.T) {
+	setEnvVariable(string(ProviderTestParameter), "env value")
+	p := NewEnvProvider()
+
+	values, err := p.Provide([]Parameter{ProviderTestParameter})
+
+	assert.Empty(t, err)
+	assert.Equal(t, map[Parameter]string{ProviderTestParameter: "env value"}, values)
+}
+
+func TestFlagProvider_WithAFlagSet_ReturnsItsValue(t *testing.T) {
+	p := NewFlagProvider([]string{"-PROVIDER_TEST_PARAMETER=flag value"})
+
+	values, err := p.Provide([]Parameter{ProviderTestParameter, TestParameter})
+
+	assert.Empty(t, err)
+	assert.Equal(t, map[Parameter]string{ProviderTestParameter: "flag value"}, values)
+}
+
+func TestFlagProvider_WithAnUnknownFlag_ReturnsAnError(t *testing.T) {
+	p := NewFlagProvider([]string{"-UNKNOWN_PARAMETER=value"})
+
+	_, err := p.Provide([]Parameter{ProviderTestParameter})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFlagsAreIncorrect, err)
+}
+
+func TestMapProvider_WithoutAValue_OmitsTheParameter(t *testing.T) {
+	p := NewMapProvider("map", map[string]string{"OTHER_PARAMETER": "value"})
+
+	values, err := p.Provide([]Parameter{ProviderTestParameter})
+
+	assert.Empty(t, err)
+	assert.Empty(t, values)
+}
+
+func TestParse_WithSeveralProviders_TakesTheLastProvidedValue(t *testing.T) {
+	config := NewConfig(
+		NewMapProvider("defaults", map[string]string{
+			string(ProviderTestParameter): "default value",
+			string(TestParameter):         "default value",
+		}),
+		NewMapProvider("overrides", map[string]string{string(ProviderTestParameter): "overridden value"}),
+	)
+
+	config.RegisterStringParameter(ProviderTestParameter)
+	config.RegisterStringParameter(TestParameter)
+	err := config.Parse()
+
+	assert.Empty(t, err)
+	assert.Equal(t, "overridden value", config.GetValue(ProviderTestParameter))
+	assert.Equal(t, "overrides", config.GetSource(ProviderTestParameter))
+	assert.Equal(t, "default value", config.GetValue(TestParameter))
+	assert.Equal(t, "defaults", config.GetSource(TestParameter))
+}
+
+func TestParse_WithAParameterNotProvided_ReportsAnEmptySource(t *testing.T) {
+	config := NewConfig(NewMapProvider("map", map[string]string{}))
+
+	config.RegisterStringParameter(ProviderTestParameter)
+	err := config.Parse()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigParameterIsEmpty, err)
+	assert.Empty(t, config.GetSource(ProviderTestParameter))
+}
+
+func TestParse_WithAFailingProvider_ReturnsAnError(t *testing.T) {
+	config := NewConfig(NewFlagProvider([]string{"-UNKNOWN_PARAMETER=value"}))
+
+	config.RegisterStringParameter(ProviderTestParameter)
+	err := config.Parse()
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFlagsAreIncorrect, err)
+}
+
+func TestParseFrom_WithArgsAndEnv_TakesTheFlagValuesWithPrecedence(t *testing.T) {
+	config := NewConfig()
+
+	config.RegisterStringParameter(TestParameter)
+	config.RegisterStringParameter(ProviderTestParameter)
+	err := config.ParseFrom(
+		[]string{"-TEST_PARAMETER=flag value"},
+		map[string]string{string(TestParameter): "env value", string(ProviderTestParameter): "env value"},
+	)
+
+	assert.Empty(t, err)
+	assert.Equal(t, "flag value", config.GetValue(TestParameter))
+	assert.Equal(t, SourceFlags, config.GetSource(TestParameter))
+	assert.Equal(t, "env value", config.GetValue(ProviderTestParameter))
+	assert.Equal(t, SourceEnv, config.GetSource(ProviderTestParameter))
+}
+
+func TestParseFrom_WithAnUnknownFlag_ReturnsAnError(t *testing.T) {
+	config := NewConfig()
+
+	config.RegisterStringParameter(TestParameter)
+	err := config.ParseFrom([]string{"-UNKNOWN_PARAMETER=value"}, map[string]string{})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigFlagsAreIncorrect, err)
+}
+
+func TestParseFrom_WithAHelpFlag_WritesTheHelpAndReturnsAnError(t *testing.T) {
+	config := NewConfig()
+	output := &bytes.Buffer{}
+
+	config.RegisterStringParameter(TestParameter, Description("Test parameter."), Example("value"))
+	config.SetHelpOutput(output)
+	err := config.ParseFrom([]string{"-help"}, map[string]string{})
+
+	assert.Error(t, err)
+	helper.AssertError(t, ErrConfigHelpIsRequested, err)
+	assert.Contains(t, output.String(), "-TEST_PARAMETER string")
+	assert.Contains(t, output.String(), "Test parameter.")
+	assert.Contains(t, output.String(), "example: value")
+}
+
+func TestParseFrom_WithAnUnknownFlag_WritesNoHelp(t *testing.T) {
+	config := NewConfig()
+	output := &bytes.Buffer{}
+
+	config.RegisterStringParameter(TestParameter)
+	config.SetHelpOutput(output)
+	err := config.ParseFrom([]string{"-UNKNOWN_PARAMETER=value"}, map[string]string{})
+
+	helper.AssertError(t, ErrConfigFlagsAreIncorrect, err)
+	assert.Empty(t, output.String())
+}
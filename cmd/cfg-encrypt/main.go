@@ -0,0 +1,90 @@
+//
+// Command cfg-encrypt encrypts a configuration parameter value for the public key of the application.
+// The result is the enc:-prefixed value to be put into a configuration file or a manifest. The application decrypts it
+// during the configuration parsing with the private key set by the CONFIG_PRIVATE_KEY parameter.
+//
+// Usage:
+//
+//		cfg-encrypt -key <base64 public key> <value>
+//		echo -n <value> | cfg-encrypt -key-file public.key
+//
+package main
+
+import (
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gopkg.in/virgil.v4/virgilcrypto"
+
+	"github.com/ameteiko/golang-kit/cfg"
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+func main() {
+	key := flag.String("key", "", "base64-encoded public key")
+	keyFile := flag.String("key-file", "", "public key file")
+	flag.Parse()
+
+	publicKey, err := readPublicKey(*key, *keyFile)
+	if nil != err {
+		errors.ReportStartupErrorAndExit(err)
+	}
+
+	value, err := readValue(flag.Args())
+	if nil != err {
+		errors.ReportStartupErrorAndExit(err)
+	}
+
+	encryptedValue, err := cfg.EncryptValue(value, publicKey)
+	if nil != err {
+		errors.ReportStartupErrorAndExit(err)
+	}
+	fmt.Println(encryptedValue)
+}
+
+//
+// readPublicKey returns the public key passed as a base64 string or as a file.
+//
+func readPublicKey(key, keyFile string) (virgilcrypto.PublicKey, error) {
+	var err error
+	var keyBytes []byte
+
+	switch {
+	case "" != key:
+		keyBytes, err = base64.StdEncoding.DecodeString(key)
+	case "" != keyFile:
+		keyBytes, err = ioutil.ReadFile(keyFile)
+	default:
+		return nil, errors.New("either -key or -key-file is required")
+	}
+	if nil != err {
+		return nil, errors.WithMessage(err, "cfg-encrypt@readPublicKey")
+	}
+
+	publicKey, err := virgilcrypto.DecodePublicKey(keyBytes)
+	if nil != err {
+		return nil, errors.WithMessage(err, "cfg-encrypt@readPublicKey")
+	}
+
+	return publicKey, nil
+}
+
+//
+// readValue returns the value passed as the argument or the standard input.
+//
+func readValue(args []string) ([]byte, error) {
+	if 0 < len(args) {
+		return []byte(strings.Join(args, " ")), nil
+	}
+
+	value, err := ioutil.ReadAll(os.Stdin)
+	if nil != err {
+		return nil, errors.WithMessage(err, "cfg-encrypt@readValue")
+	}
+
+	return value, nil
+}
@@ -2,6 +2,8 @@ package cassandra
 
 import (
 	"github.com/gocql/gocql"
+
+	"github.com/ameteiko/golang-kit/errors"
 )
 
 //
@@ -9,6 +11,15 @@ import (
 //
 const (
 	DefaultConsistencyLevel = gocql.LocalQuorum
+	CompressionSnappy       = "snappy"
+	PingStatement           = "SELECT now() FROM system.local"
+)
+
+//
+// Database errors.
+//
+var (
+	ErrCompressionIsNotSupported = errors.NewError("cassandra compression is not supported")
 )
 
 //
@@ -49,3 +60,22 @@ func (db *DBAdapter) Query(stmt string, values ...interface{}) *gocql.Query {
 func (db *DBAdapter) Close() {
 	db.session.Close()
 }
+
+//
+// Ping checks the cluster is reachable by querying the local node.
+//
+func (db *DBAdapter) Ping() error {
+	if err := db.session.Query(PingStatement).Consistency(gocql.One).Exec(); nil != err {
+		return errors.WithMessage(err, "kit-cassandra@DBAdapter.Ping")
+	}
+
+	return nil
+}
+
+//
+// GetHealthName returns the health dependency name, the same as health.DependencyCassandra.
+//
+func (db *DBAdapter) GetHealthName() string {
+
+	return "cassandra"
+}
@@ -29,10 +29,31 @@ func NewConnection() *Connection {
 
 //
 // Connect connects to the cassandra database cluster.
+// The connection options that are not set in the connection info keep the gocql defaults, the consistency level
+// defaults to the connection one.
 //
 func (c *Connection) Connect(connectionInfo ConnectionInfoProvider) (*DBAdapter, error) {
+	cluster, err := c.newCluster(connectionInfo)
+	if nil != err {
+		return nil, errors.WithMessage(err, "kit-cassandra@Connection.Connect")
+	}
+
+	dbSession, err := cluster.CreateSession()
+	if err != nil {
+		return nil, errors.Wrap(err, "was unable to establish a connection to the database cluster")
+
+	}
+
+	return NewDBAdapter(dbSession, cluster.Consistency), nil
+}
+
+//
+// newCluster returns the cluster configuration for the connection info.
+//
+func (c *Connection) newCluster(connectionInfo ConnectionInfoProvider) (*gocql.ClusterConfig, error) {
 	cluster := gocql.NewCluster(connectionInfo.GetHosts()...)
 	cluster.Keyspace = connectionInfo.GetKeyspace()
+	cluster.Consistency = c.consistency
 
 	if connectionInfo.IsAuthorizationRequired() {
 		cluster.Authenticator = gocql.PasswordAuthenticator{
@@ -47,11 +68,54 @@ func (c *Connection) Connect(connectionInfo ConnectionInfoProvider) (*DBAdapter,
 		}
 	}
 
-	dbSession, err := cluster.CreateSession()
-	if err != nil {
-		return nil, errors.Wrap(err, "was unable to establish a connection to the database cluster")
+	if "" != connectionInfo.GetConsistency() {
+		consistency, err := gocql.ParseConsistencyWrapper(connectionInfo.GetConsistency())
+		if nil != err {
+			return nil, errors.WithMessage(
+				err,
+				`kit-cassandra@Connection.newCluster [consistency (%s)]`,
+				connectionInfo.GetConsistency(),
+			)
+		}
+		cluster.Consistency = consistency
+	}
 
+	if 0 != connectionInfo.GetPort() {
+		cluster.Port = connectionInfo.GetPort()
+	}
+	if 0 != connectionInfo.GetConnectTimeout() {
+		cluster.ConnectTimeout = connectionInfo.GetConnectTimeout()
+	}
+	if 0 != connectionInfo.GetTimeout() {
+		cluster.Timeout = connectionInfo.GetTimeout()
+	}
+	if 0 != connectionInfo.GetProtocolVersion() {
+		cluster.ProtoVersion = connectionInfo.GetProtocolVersion()
+	}
+	if 0 != connectionInfo.GetNumConns() {
+		cluster.NumConns = connectionInfo.GetNumConns()
+	}
+
+	switch connectionInfo.GetCompression() {
+	case "":
+	case CompressionSnappy:
+		cluster.Compressor = gocql.SnappyCompressor{}
+	default:
+		return nil, errors.WithMessage(
+			ErrCompressionIsNotSupported,
+			`kit-cassandra@Connection.newCluster [compression (%s)]`,
+			connectionInfo.GetCompression(),
+		)
+	}
+
+	if connectionInfo.IsTLS() {
+		cluster.SslOpts = &gocql.SslOptions{
+			CaPath:                 connectionInfo.GetTLSCAFile(),
+			CertPath:               connectionInfo.GetTLSCertFile(),
+			KeyPath:                connectionInfo.GetTLSKeyFile(),
+			EnableHostVerification: true,
+		}
 	}
 
-	return NewDBAdapter(dbSession, c.consistency), nil
+	return cluster, nil
 }
@@ -1,5 +1,9 @@
 package cassandra
 
+import (
+	"time"
+)
+
 //
 // ConnectionInfoProvider declares all the connection info getters.
 //
@@ -9,7 +13,18 @@ type ConnectionInfoProvider interface {
 	GetDataCenter() string
 	GetUser() string
 	GetPassword() string
+	GetPort() int
+	GetConnectTimeout() time.Duration
+	GetTimeout() time.Duration
+	GetConsistency() string
+	GetProtocolVersion() int
+	GetCompression() string
+	GetNumConns() int
+	GetTLSCAFile() string
+	GetTLSCertFile() string
+	GetTLSKeyFile() string
 
 	IsAuthorizationRequired() bool
 	IsDCAware() bool
+	IsTLS() bool
 }
@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"database/sql"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Adapter interface wraps the access to the database.
+// It is used to decouple the database layer.
+//
+type Adapter interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Begin() (*sql.Tx, error)
+	Ping() error
+	Close()
+}
+
+//
+// DBAdapter is the database DBAdapter object.
+//
+type DBAdapter struct {
+	db *sql.DB
+}
+
+//
+// NewDBAdapter returns an instance of the database DBAdapter.
+//
+func NewDBAdapter(db *sql.DB) *DBAdapter {
+	return &DBAdapter{db}
+}
+
+//
+// Query executes a query that returns rows.
+//
+func (a *DBAdapter) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return a.db.Query(query, args...)
+}
+
+//
+// QueryRow executes a query that is expected to return at most one row.
+//
+func (a *DBAdapter) QueryRow(query string, args ...interface{}) *sql.Row {
+	return a.db.QueryRow(query, args...)
+}
+
+//
+// Exec executes a query without returning any rows.
+//
+func (a *DBAdapter) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return a.db.Exec(query, args...)
+}
+
+//
+// Begin starts a transaction.
+//
+func (a *DBAdapter) Begin() (*sql.Tx, error) {
+	return a.db.Begin()
+}
+
+//
+// Ping verifies the database connection is alive.
+//
+func (a *DBAdapter) Ping() error {
+	if err := a.db.Ping(); nil != err {
+		return errors.WithMessage(err, "kit-sql@DBAdapter.Ping")
+	}
+
+	return nil
+}
+
+//
+// GetHealthName returns the health dependency name, the same as health.DependencyPostgres.
+//
+func (a *DBAdapter) GetHealthName() string {
+
+	return "postgres"
+}
+
+//
+// Close closes the database connections pool.
+//
+func (a *DBAdapter) Close() {
+	a.db.Close()
+}
@@ -0,0 +1,16 @@
+package sql
+
+import (
+	"time"
+)
+
+//
+// ConnectionInfoProvider declares all the connection info getters.
+//
+type ConnectionInfoProvider interface {
+	GetDSN() string
+	GetMaxOpenConns() int
+	GetMaxIdleConns() int
+	GetConnMaxLifetime() time.Duration
+	GetConnMaxIdleTime() time.Duration
+}
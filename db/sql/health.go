@@ -0,0 +1,21 @@
+package sql
+
+import (
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// NewHealthChecker returns a health dependency checker pinging the database.
+// It is registered as health.DispatchManager.RegisterDependency(health.DependencyPostgres, sql.NewHealthChecker(db)),
+// the returned function is assignable to health.DependencyChecker, so the package does not depend on the HTTP health.
+//
+func NewHealthChecker(adapter Adapter) func() error {
+
+	return func() error {
+		if err := adapter.Ping(); nil != err {
+			return errors.WithMessage(err, "kit-sql@HealthChecker")
+		}
+
+		return nil
+	}
+}
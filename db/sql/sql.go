@@ -0,0 +1,77 @@
+//
+// Package sql provides the database/sql connector and adapter for the SQL databases configured with the cfg package.
+// The database driver has to be imported by the application, i.e. github.com/lib/pq for the "postgres" driver.
+//
+package sql
+
+import (
+	"database/sql"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Database constants.
+//
+const (
+	DefaultDriverName = "postgres"
+)
+
+//
+// Connector is a database connector interface.
+//
+type Connector interface {
+	Connect(connectionInfo ConnectionInfoProvider) (*DBAdapter, error)
+}
+
+//
+// Connection is an SQL database connection class.
+//
+type Connection struct {
+	driverName string
+}
+
+//
+// NewConnection returns a new SQL database connection instance for the default driver.
+//
+func NewConnection() *Connection {
+	return &Connection{DefaultDriverName}
+}
+
+//
+// NewDriverConnection returns a new SQL database connection instance for the driver.
+//
+func NewDriverConnection(driverName string) *Connection {
+	return &Connection{driverName}
+}
+
+//
+// Connect opens the database connections pool with the pool settings of the connection info and checks the database
+// is reachable.
+//
+func (c *Connection) Connect(connectionInfo ConnectionInfoProvider) (*DBAdapter, error) {
+	db, err := sql.Open(c.driverName, connectionInfo.GetDSN())
+	if nil != err {
+		return nil, errors.WithMessage(err, `kit-sql@Connection.Connect [driver (%s)]`, c.driverName)
+	}
+
+	if 0 != connectionInfo.GetMaxOpenConns() {
+		db.SetMaxOpenConns(connectionInfo.GetMaxOpenConns())
+	}
+	if 0 != connectionInfo.GetMaxIdleConns() {
+		db.SetMaxIdleConns(connectionInfo.GetMaxIdleConns())
+	}
+	if 0 != connectionInfo.GetConnMaxLifetime() {
+		db.SetConnMaxLifetime(connectionInfo.GetConnMaxLifetime())
+	}
+	if 0 != connectionInfo.GetConnMaxIdleTime() {
+		db.SetConnMaxIdleTime(connectionInfo.GetConnMaxIdleTime())
+	}
+
+	if err := db.Ping(); nil != err {
+		db.Close()
+		return nil, errors.Wrap(err, "was unable to establish a connection to the database")
+	}
+
+	return NewDBAdapter(db), nil
+}
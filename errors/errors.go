@@ -25,6 +25,11 @@ var (
 		30002,
 		"Response encoding error. The request itself succeeded.",
 	)
+	ErrMethodNotAllowed = HTTPError{
+		status:  http.StatusMethodNotAllowed,
+		Code:    30003,
+		Message: "Request method is not allowed for the resource. Check the Allow header.",
+	}
 
 	ErrInternalServerError = NewHTTP500Error(
 		10000,
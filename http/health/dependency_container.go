@@ -0,0 +1,91 @@
+package health
+
+import (
+	"github.com/ameteiko/golang-kit/cfg"
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// Pinger is a dependency checked by pinging it, like a database or a cache client.
+//
+type Pinger interface {
+	Ping() error
+}
+
+//
+// NameProvider provides the health dependency name, like DependencyCassandra or DependencyRedis.
+//
+type NameProvider interface {
+	GetHealthName() string
+}
+
+//
+// WithDependencyChecks returns the container build option registering the dependency checks with the dispatcher,
+// see RegisterContainerDependencies. The option builds every application dependency of the container on Build, as
+// cfg.EagerSingletons does, because a dependency has to be built to be checked.
+//
+func WithDependencyChecks(dispatcher Dispatcher) cfg.BuildOption {
+
+	return cfg.AfterBuild(func(container *cfg.DIContainer) error {
+
+		return RegisterContainerDependencies(dispatcher, container)
+	})
+}
+
+//
+// RegisterContainerDependencies builds the application dependencies of the container and registers the ones
+// implementing Pinger with the dispatcher, nil dependencies, like the disabled ones, are skipped. A dependency is
+// registered by its health name if it implements NameProvider and no other dependency has the same health name,
+// e.g. two redis clients, and by its DI name otherwise.
+//
+func RegisterContainerDependencies(dispatcher Dispatcher, container *cfg.DIContainer) error {
+	var depNames []string
+	pingers := make(map[string]Pinger)
+	healthNames := make(map[string]string)
+	healthNameCounts := make(map[string]int)
+	for _, depName := range container.GetDependencyNames() {
+		obj, err := container.SafeGet(depName)
+		if nil != err {
+			return errors.WithMessage(err, "kit-health@RegisterContainerDependencies")
+		}
+		if nil == obj {
+			continue
+		}
+
+		pinger, ok := obj.(Pinger)
+		if !ok {
+			continue
+		}
+		depNames = append(depNames, depName)
+		pingers[depName] = pinger
+		healthNames[depName] = depName
+		if nameProvider, ok := obj.(NameProvider); ok {
+			healthNames[depName] = nameProvider.GetHealthName()
+			healthNameCounts[nameProvider.GetHealthName()]++
+		}
+	}
+
+	for _, depName := range depNames {
+		name := healthNames[depName]
+		if 1 < healthNameCounts[name] {
+			name = depName
+		}
+		dispatcher.RegisterDependency(name, newPingChecker(name, pingers[depName]))
+	}
+
+	return nil
+}
+
+//
+// newPingChecker returns the dependency checker pinging the dependency.
+//
+func newPingChecker(name string, pinger Pinger) DependencyChecker {
+
+	return func() error {
+		if err := pinger.Ping(); nil != err {
+			return errors.WithMessage(err, `kit-health@PingChecker [dependency (%s)]`, name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sarulabs/di"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+type pingerMock struct {
+	healthName string
+	err        error
+}
+
+func (m pingerMock) Ping() error {
+
+	return m.err
+}
+
+type namedPingerMock struct {
+	pingerMock
+}
+
+func (m namedPingerMock) GetHealthName() string {
+
+	return m.healthName
+}
+
+func newObjectRegistrar(obj interface{}) func(ctx di.Context) (interface{}, error) {
+
+	return func(ctx di.Context) (interface{}, error) {
+
+		return obj, nil
+	}
+}
+
+func getDependencyNames(dispatcher *DispatchManager) []string {
+	var names []string
+	for _, dep := range dispatcher.deps {
+		names = append(names, dep.GetName())
+	}
+
+	return names
+}
+
+func TestWithDependencyChecks_WithPingers_RegistersThem(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	container := cfg.NewDIContainer()
+	cassandra := newObjectRegistrar(namedPingerMock{pingerMock{healthName: DependencyCassandra}})
+	container.RegisterDependency("db", cassandra, nil)
+	container.RegisterDependency("storage", newObjectRegistrar(pingerMock{err: errors.New("down")}), nil)
+	container.RegisterDependency("logger", newObjectRegistrar("logger"), nil)
+
+	err := container.Build(WithDependencyChecks(dispatcher))
+	_, checkErr := dispatcher.deps[1].Check()
+
+	assert.Empty(t, err)
+	assert.Equal(t, []string{DependencyCassandra, "storage"}, getDependencyNames(dispatcher))
+	assert.Contains(t, checkErr.Error(), "down")
+}
+
+func TestWithDependencyChecks_WithTheSameHealthNames_RegistersThemByTheDIName(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	container := cfg.NewDIContainer()
+	redis := newObjectRegistrar(namedPingerMock{pingerMock{healthName: DependencyRedis}})
+	container.RegisterDependency("cache_redis", redis, nil)
+	container.RegisterDependency("session_redis", redis, nil)
+
+	err := container.Build(WithDependencyChecks(dispatcher))
+
+	assert.Empty(t, err)
+	assert.Equal(t, []string{"cache_redis", "session_redis"}, getDependencyNames(dispatcher))
+}
+
+func TestWithDependencyChecks_WithAFailingDependency_ReturnsAnError(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	container := cfg.NewDIContainer()
+	container.RegisterDependency("db", func(ctx di.Context) (interface{}, error) {
+
+		return nil, errors.New("connection error")
+	}, nil)
+
+	err := container.Build(WithDependencyChecks(dispatcher))
+
+	assert.NotEmpty(t, err)
+	assert.Empty(t, dispatcher.deps)
+}
+
+func TestWithDependencyChecks_WithANilDependency_SkipsIt(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	container := cfg.NewDIContainer()
+	container.RegisterDependency("disabled_cache", newObjectRegistrar(nil), nil)
+	container.RegisterDependency("storage", newObjectRegistrar(pingerMock{}), nil)
+
+	err := container.Build(WithDependencyChecks(dispatcher))
+
+	assert.Empty(t, err)
+	assert.Equal(t, []string{"storage"}, getDependencyNames(dispatcher))
+}
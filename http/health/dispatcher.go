@@ -2,6 +2,7 @@ package health
 
 import (
 	"net/http"
+	"sync"
 )
 
 //
@@ -35,13 +36,60 @@ type Dispatcher interface {
 	RegisterDependency(name string, checker DependencyChecker)
 }
 
+//
+// ConfigDispatcher is a health manager interface of the configuration introspection.
+// It is implemented by DispatchManager apart from Dispatcher, so the existing Dispatcher implementations are intact.
+//
+type ConfigDispatcher interface {
+	//
+	// GetConfigHandler returns health config handler.
+	//
+	GetConfigHandler() http.Handler
+
+	//
+	// GetConfigURL returns health config URL.
+	//
+	GetConfigURL() string
+
+	//
+	// RegisterConfig registers the configuration to introspect with the config handler protected by the token.
+	//
+	RegisterConfig(config ConfigStateProvider, token string)
+}
+
+//
+// FeaturesDispatcher is a health manager interface of the feature flags exposure.
+// It is implemented by DispatchManager apart from Dispatcher, so the existing Dispatcher implementations are intact.
+//
+type FeaturesDispatcher interface {
+	//
+	// GetFeaturesHandler returns health features handler.
+	//
+	GetFeaturesHandler() http.Handler
+
+	//
+	// GetFeaturesURL returns health features URL.
+	//
+	GetFeaturesURL() string
+
+	//
+	// RegisterFeatures registers the feature flags to expose with the features handler protected by the token.
+	//
+	RegisterFeatures(features FeatureStateProvider, token string)
+}
+
 //
 // DispatchManager is an object that dispatches web-service health functionality.
 //
 type DispatchManager struct {
-	deps       []Dependency
-	buildInfo  BuildVersionProvider
-	urlLocator URLLocator
+	deps          []Dependency
+	buildInfo     BuildVersionProvider
+	urlLocator    *URL
+	config        ConfigStateProvider
+	configToken   string
+	features      FeatureStateProvider
+	featuresToken string
+	mu            sync.RWMutex
 }
 
 //
@@ -70,6 +118,26 @@ func (d *DispatchManager) GetStatusHandler() http.Handler {
 	return newStatusHandler(d.buildInfo, d.deps)
 }
 
+//
+// GetConfigHandler returns an instance of the config handler.
+// The handler responds with HTTP 403 until a configuration with a non-empty token is registered, the registration
+// is looked up on every request, so the handler can be mounted before RegisterConfig is called.
+//
+func (d *DispatchManager) GetConfigHandler() http.Handler {
+
+	return newConfigHandler(d)
+}
+
+//
+// GetFeaturesHandler returns an instance of the features handler.
+// The handler responds with HTTP 403 until the feature flags with a non-empty token are registered, the registration
+// is looked up on every request, so the handler can be mounted before RegisterFeatures is called.
+//
+func (d *DispatchManager) GetFeaturesHandler() http.Handler {
+
+	return newFeaturesHandler(d)
+}
+
 //
 // GetInfoURL returns info URL.
 //
@@ -86,6 +154,46 @@ func (d *DispatchManager) GetStatusURL() string {
 	return d.urlLocator.GetStatusURL()
 }
 
+//
+// GetConfigURL returns config URL.
+//
+func (d *DispatchManager) GetConfigURL() string {
+
+	return d.urlLocator.GetConfigURL()
+}
+
+//
+// RegisterConfig registers the configuration to introspect with the config handler protected by the token.
+//
+func (d *DispatchManager) RegisterConfig(config ConfigStateProvider, token string) {
+	d.mu.Lock()
+	{
+		d.config = config
+		d.configToken = token
+	}
+	d.mu.Unlock()
+}
+
+//
+// GetFeaturesURL returns features URL.
+//
+func (d *DispatchManager) GetFeaturesURL() string {
+
+	return d.urlLocator.GetFeaturesURL()
+}
+
+//
+// RegisterFeatures registers the feature flags to expose with the features handler protected by the token.
+//
+func (d *DispatchManager) RegisterFeatures(features FeatureStateProvider, token string) {
+	d.mu.Lock()
+	{
+		d.features = features
+		d.featuresToken = token
+	}
+	d.mu.Unlock()
+}
+
 //
 // RegisterDependency registers a dependency to track.
 //
@@ -93,3 +201,23 @@ func (d *DispatchManager) RegisterDependency(name string, checker DependencyChec
 	dep := Dependency{name: name, checker: checker}
 	d.deps = append(d.deps, dep)
 }
+
+//
+// getConfig returns the registered configuration and its token.
+//
+func (d *DispatchManager) getConfig() (ConfigStateProvider, string) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.config, d.configToken
+}
+
+//
+// getFeatures returns the registered feature flags and their token.
+//
+func (d *DispatchManager) getFeatures() (FeatureStateProvider, string) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.features, d.featuresToken
+}
@@ -0,0 +1,81 @@
+package health
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ameteiko/golang-kit/errors"
+)
+
+//
+// AdminTokenScheme is the authorization scheme of the admin endpoints token.
+//
+const AdminTokenScheme = "Bearer"
+
+//
+// Admin endpoints errors.
+//
+var (
+	ErrAdminEndpointIsDisabled = errors.HTTPError{Code: 20000, Message: "Admin endpoint is disabled."}
+	ErrAdminTokenIsInvalid     = errors.HTTPError{Code: 20001, Message: "Admin endpoint token is invalid."}
+)
+
+//
+// serveAdmin writes the JSON response object returned by the getter to the request authorized with the token.
+// The admin endpoints, like the config and the features ones, require the "Authorization: Bearer <token>" header and
+// are disabled when the token is empty or their state provider is not registered.
+//
+func serveAdmin(
+	w http.ResponseWriter,
+	req *http.Request,
+	token string,
+	isRegistered bool,
+	getResponse func() interface{},
+) {
+	if "" == token || !isRegistered {
+		writeAdminError(w, http.StatusForbidden, ErrAdminEndpointIsDisabled)
+
+		return
+	}
+
+	if !isAdminAuthorized(req, token) {
+		w.Header().Set("WWW-Authenticate", AdminTokenScheme)
+		writeAdminError(w, http.StatusUnauthorized, ErrAdminTokenIsInvalid)
+
+		return
+	}
+
+	resp, err := json.Marshal(getResponse())
+	if nil != err {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+//
+// isAdminAuthorized returns true if the request authorization header contains the admin token.
+//
+func isAdminAuthorized(req *http.Request, token string) bool {
+	authorization := req.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, AdminTokenScheme+" ") {
+		return false
+	}
+	requestToken := strings.TrimSpace(strings.TrimPrefix(authorization, AdminTokenScheme+" "))
+
+	return 1 == subtle.ConstantTimeCompare([]byte(requestToken), []byte(token))
+}
+
+//
+// writeAdminError writes the admin endpoint error response.
+//
+func writeAdminError(w http.ResponseWriter, status int, err errors.HTTPError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write([]byte(err.Error()))
+}
@@ -0,0 +1,47 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+//
+// ConfigStateProvider provides the states of the registered configuration parameters.
+//
+type ConfigStateProvider interface {
+	GetParameterStates() []cfg.ParameterState
+}
+
+//
+// configResponse is a config endpoint response.
+//
+type configResponse struct {
+	Parameters []cfg.ParameterState `json:"parameters"`
+}
+
+//
+// ConfigHandler is an HTTP health handler for the config endpoint.
+//
+type ConfigHandler struct {
+	dispatcher *DispatchManager
+}
+
+//
+// newConfigHandler returns a new config handler instance.
+//
+func newConfigHandler(dispatcher *DispatchManager) *ConfigHandler {
+
+	return &ConfigHandler{dispatcher: dispatcher}
+}
+
+//
+// ServeHTTP returns the states of the configuration parameters registered with the dispatcher.
+//
+func (h *ConfigHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	config, token := h.dispatcher.getConfig()
+	serveAdmin(w, req, token, nil != config, func() interface{} {
+
+		return configResponse{Parameters: config.GetParameterStates()}
+	})
+}
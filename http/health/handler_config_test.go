@@ -0,0 +1,90 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+type configStateProviderMock struct {
+	states []cfg.ParameterState
+}
+
+func (m configStateProviderMock) GetParameterStates() []cfg.ParameterState {
+
+	return m.states
+}
+
+func TestNewDispatcher_WithTheConfigIntrospection_ImplementsTheConfigInterfaces(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+
+	assert.Implements(t, (*Dispatcher)(nil), dispatcher)
+	assert.Implements(t, (*ConfigDispatcher)(nil), dispatcher)
+	assert.Implements(t, (*ConfigURLLocator)(nil), NewURLLocator())
+	assert.Equal(t, "/health/config", dispatcher.GetConfigURL())
+}
+
+func TestConfigHandler_WithAValidToken_ReturnsTheParameterStates(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	states := []cfg.ParameterState{{Name: "SECRET", Type: cfg.TypeString, Value: cfg.RedactedValue, Valid: true}}
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetConfigURL(), nil)
+	request.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+	var response configResponse
+
+	dispatcher.RegisterConfig(configStateProviderMock{states}, "token")
+	dispatcher.GetConfigHandler().ServeHTTP(recorder, request)
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	assert.Empty(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, states, response.Parameters)
+}
+
+func TestConfigHandler_WithAnInvalidToken_ReturnsUnauthorized(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetConfigURL(), nil)
+	request.Header.Set("Authorization", "Bearer invalid")
+	recorder := httptest.NewRecorder()
+
+	dispatcher.RegisterConfig(configStateProviderMock{}, "token")
+	dispatcher.GetConfigHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Equal(t, ErrAdminTokenIsInvalid.Error(), recorder.Body.String())
+}
+
+func TestConfigHandler_WithoutAToken_IsDisabled(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetConfigURL(), nil)
+	request.Header.Set("Authorization", "Bearer ")
+	recorder := httptest.NewRecorder()
+
+	dispatcher.RegisterConfig(configStateProviderMock{}, "")
+	dispatcher.GetConfigHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestConfigHandler_WithAConfigRegisteredAfterMounting_ReturnsTheParameterStates(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	states := []cfg.ParameterState{{Name: "PARAMETER", Type: cfg.TypeString, Value: "value", Valid: true}}
+	handler := dispatcher.GetConfigHandler()
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetConfigURL(), nil)
+	request.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+	var response configResponse
+
+	dispatcher.RegisterConfig(configStateProviderMock{states}, "token")
+	handler.ServeHTTP(recorder, request)
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	assert.Empty(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, states, response.Parameters)
+}
@@ -0,0 +1,47 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+//
+// FeatureStateProvider provides the states of the registered feature flags.
+//
+type FeatureStateProvider interface {
+	GetFeatureStates() []cfg.FeatureState
+}
+
+//
+// featuresResponse is a features endpoint response.
+//
+type featuresResponse struct {
+	Features []cfg.FeatureState `json:"features"`
+}
+
+//
+// FeaturesHandler is an HTTP health handler for the features endpoint.
+//
+type FeaturesHandler struct {
+	dispatcher *DispatchManager
+}
+
+//
+// newFeaturesHandler returns a new features handler instance.
+//
+func newFeaturesHandler(dispatcher *DispatchManager) *FeaturesHandler {
+
+	return &FeaturesHandler{dispatcher: dispatcher}
+}
+
+//
+// ServeHTTP returns the current states of the feature flags registered with the dispatcher.
+//
+func (h *FeaturesHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	features, token := h.dispatcher.getFeatures()
+	serveAdmin(w, req, token, nil != features, func() interface{} {
+
+		return featuresResponse{Features: features.GetFeatureStates()}
+	})
+}
@@ -0,0 +1,74 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/cfg"
+)
+
+type featureStateProviderMock struct {
+	states []cfg.FeatureState
+}
+
+func (m featureStateProviderMock) GetFeatureStates() []cfg.FeatureState {
+
+	return m.states
+}
+
+func TestNewDispatcher_WithTheFeaturesExposure_ImplementsTheFeaturesInterfaces(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+
+	assert.Implements(t, (*FeaturesDispatcher)(nil), dispatcher)
+	assert.Implements(t, (*FeaturesURLLocator)(nil), NewURLLocator())
+	assert.Equal(t, "/health/features", dispatcher.GetFeaturesURL())
+}
+
+func TestFeaturesHandler_WithAValidToken_ReturnsTheFeatureStates(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	states := []cfg.FeatureState{{Name: "new_search", Parameter: "FEATURE_NEW_SEARCH", Value: "25%", Percentage: 25}}
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetFeaturesURL(), nil)
+	request.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+	var response featuresResponse
+
+	dispatcher.RegisterFeatures(featureStateProviderMock{states}, "token")
+	dispatcher.GetFeaturesHandler().ServeHTTP(recorder, request)
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	assert.Empty(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, states, response.Features)
+}
+
+func TestFeaturesHandler_WithoutRegistration_IsDisabled(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetFeaturesURL(), nil)
+	recorder := httptest.NewRecorder()
+
+	dispatcher.GetFeaturesHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestFeaturesHandler_WithFeaturesRegisteredAfterMounting_ReturnsTheFeatureStates(t *testing.T) {
+	dispatcher := NewDispatcher(&BuildVersion{})
+	states := []cfg.FeatureState{{Name: "new_search", Parameter: "FEATURE_NEW_SEARCH", Value: "true", Percentage: 100}}
+	handler := dispatcher.GetFeaturesHandler()
+	request := httptest.NewRequest(http.MethodGet, dispatcher.GetFeaturesURL(), nil)
+	request.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+	var response featuresResponse
+
+	dispatcher.RegisterFeatures(featureStateProviderMock{states}, "token")
+	handler.ServeHTTP(recorder, request)
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	assert.Empty(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, states, response.Features)
+}
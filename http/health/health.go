@@ -17,6 +17,7 @@ type DependencyChecker func() error
 const (
 	DependencyCassandra = "cassandra"
 	DependencyRedis     = "redis"
+	DependencyPostgres  = "postgres"
 
 	DependencyVirgilCrypto  = "virgil_crypto"
 	DependencyVirgilCardsV5 = "virgil_cards_v5"
@@ -16,6 +16,26 @@ type URLLocator interface {
 	GetStatusURL() string
 }
 
+//
+// ConfigURLLocator is a health checker config URL locator interface.
+//
+type ConfigURLLocator interface {
+	//
+	// GetConfigURL returns a config URL.
+	//
+	GetConfigURL() string
+}
+
+//
+// FeaturesURLLocator is a health checker features URL locator interface.
+//
+type FeaturesURLLocator interface {
+	//
+	// GetFeaturesURL returns a features URL.
+	//
+	GetFeaturesURL() string
+}
+
 //
 // URL is a resource url locator object.
 //
@@ -45,3 +65,19 @@ func (h *URL) GetStatusURL() string {
 
 	return "/health/status"
 }
+
+//
+// GetConfigURL returns config URL.
+//
+func (h *URL) GetConfigURL() string {
+
+	return "/health/config"
+}
+
+//
+// GetFeaturesURL returns features URL.
+//
+func (h *URL) GetFeaturesURL() string {
+
+	return "/health/features"
+}
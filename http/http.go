@@ -8,15 +8,16 @@ import (
 
 //
 // WriteResponseError writes the information about the error to the response.
+// The HTTP status is taken from the HTTP error the err wraps, like the ones created with errors.NewHTTP400Error.
 //
 func WriteResponseError(responseWriter http.ResponseWriter, err error) {
-	httpError, ok := err.(errors.HTTPErrorInfoProvider)
+	responseWriter.Header().Set("Content-Type", "application/json")
+	httpError, ok := errors.Cause(err, (*errors.HTTPErrorInfoProvider)(nil)).(errors.HTTPErrorInfoProvider)
 	if ok {
 		responseWriter.WriteHeader(httpError.GetHTTPStatus())
 	} else {
 		responseWriter.WriteHeader(http.StatusBadRequest)
 	}
 
-	responseWriter.Header().Set("Content-Type", "application/json")
 	responseWriter.Write([]byte(err.Error()))
 }
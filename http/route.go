@@ -0,0 +1,143 @@
+package http
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+//
+// route is a registered path pattern with the methods it is served for.
+//
+type route struct {
+	path    string
+	pattern routePattern
+	methods []string
+}
+
+//
+// routePattern is a path pattern compiled for the matching without serving a request.
+// It follows the pat pattern syntax: a :name part matches the path up to the next pattern character or slash, and a
+// pattern ending with a slash matches all the paths it prefixes.
+//
+type routePattern struct {
+	parts    []routePatternPart
+	isPrefix bool
+}
+
+//
+// routePatternPart is a literal part or a named parameter part of the path pattern.
+//
+type routePatternPart struct {
+	literal string
+	isParam bool
+	next    byte
+}
+
+//
+// newRoute returns a new route instance for the path pattern.
+//
+func newRoute(path string) *route {
+
+	return &route{path: path, pattern: compileRoutePattern(path)}
+}
+
+//
+// compileRoutePattern splits the path pattern into the literal and the parameter parts.
+//
+func compileRoutePattern(path string) routePattern {
+	pattern := routePattern{isPrefix: "/" != path && strings.HasSuffix(path, "/")}
+	for i := 0; i < len(path); {
+		if ':' != path[i] {
+			j := strings.IndexByte(path[i:], ':')
+			if -1 == j {
+				j = len(path) - i
+			}
+			pattern.parts = append(pattern.parts, routePatternPart{literal: path[i : i+j]})
+			i += j
+			continue
+		}
+
+		j := i + 1
+		for j < len(path) && isRouteParamChar(path[j]) {
+			j++
+		}
+		part := routePatternPart{isParam: true}
+		if j < len(path) {
+			part.next = path[j]
+		}
+		pattern.parts = append(pattern.parts, part)
+		i = j
+	}
+
+	return pattern
+}
+
+//
+// addMethods adds the methods the route is served for.
+//
+func (r *route) addMethods(methods ...string) {
+	r.methods = append(r.methods, methods...)
+}
+
+//
+// matches returns true if the route pattern matches the escaped request path.
+//
+func (p routePattern) matches(path string) bool {
+	i := 0
+	for _, part := range p.parts {
+		if i >= len(path) {
+			return false
+		}
+		if !part.isParam {
+			if !strings.HasPrefix(path[i:], part.literal) {
+				return false
+			}
+			i += len(part.literal)
+			continue
+		}
+
+		for i < len(path) && part.next != path[i] && '/' != path[i] {
+			i++
+		}
+	}
+
+	return len(path) == i || p.isPrefix
+}
+
+//
+// getAllowedMethods returns the methods of the routes matching the request path in the alphabetical order.
+// OPTIONS is allowed for every matching path, it is answered by the router.
+//
+func getAllowedMethods(routes []*route, request *http.Request) []string {
+	path := request.URL.EscapedPath()
+	allowed := make(map[string]bool)
+	for _, route := range routes {
+		if !route.pattern.matches(path) {
+			continue
+		}
+		for _, method := range route.methods {
+			allowed[method] = true
+		}
+	}
+	if 0 == len(allowed) {
+		return nil
+	}
+	allowed[http.MethodOptions] = true
+
+	methods := make([]string, 0, len(allowed))
+	for method := range allowed {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+//
+// isRouteParamChar returns true if the character is allowed in the pattern parameter name.
+//
+func isRouteParamChar(c byte) bool {
+
+	return '_' == c || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
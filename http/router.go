@@ -1,14 +1,22 @@
 package http
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/bmizerany/pat"
 
+	"github.com/ameteiko/golang-kit/cfg"
 	"github.com/ameteiko/golang-kit/errors"
 	"github.com/ameteiko/golang-kit/log"
 )
 
+//
+// requestContainerKey is a request context key of the request-scoped DI container.
+//
+type requestContainerKey struct{}
+
 //
 // HandlerProvider interface provides an HTTP handler.
 //
@@ -35,20 +43,34 @@ type Server interface {
 	//
 	Put(path string, handler RequestHandler)
 
+	//
+	// Patch registers an HTTP PATCH handler.
+	//
+	Patch(path string, handler RequestHandler)
+
 	//
 	// Delete registers an HTTP DELETE handler.
 	//
 	Delete(path string, handler RequestHandler)
+
+	//
+	// Head registers an HTTP HEAD handler.
+	//
+	Head(path string, handler RequestHandler)
 }
 
 //
 // Router represents router class.
 // It registers all the HTTP and error handlers used for the requests serving and implements the Server interface.
+// A GET handler serves HEAD requests too. OPTIONS requests to the registered paths are answered with the Allow header
+// and the requests with other methods are rejected with HTTP 405.
 //
 type Router struct {
 	httpHandler   *pat.PatternServeMux
 	requestReader requestReader
 	log           log.Logger
+	container     *cfg.DIContainer
+	routes        []*route
 }
 
 //
@@ -60,16 +82,26 @@ func NewRouter(log log.Logger) *Router {
 		requestReader: new(requestRead),
 		log:           log,
 	}
-	r.httpHandler.NotFound = http.HandlerFunc(defaultNotFoundHandler)
+	r.httpHandler.NotFound = http.HandlerFunc(r.handleUnmatched)
 
 	return &r
 }
 
 //
-// Get registers an HTTP GET httpHandler.
+// SetDIContainer sets the built application DI container.
+// The router creates a request-scoped container for each request and deletes it when the request is served. Handlers
+// get the request container with GetRequestContainer.
+//
+func (r *Router) SetDIContainer(container *cfg.DIContainer) {
+	r.container = container
+}
+
+//
+// Get registers an HTTP GET httpHandler, it serves the HEAD requests as well.
 //
 func (r *Router) Get(path string, handler RequestHandler) {
 	r.httpHandler.Get(path, r.WrapHTTPHandler(handler))
+	r.addRoute(path, http.MethodGet, http.MethodHead)
 }
 
 //
@@ -77,20 +109,39 @@ func (r *Router) Get(path string, handler RequestHandler) {
 //
 func (r *Router) Post(path string, handler RequestHandler) {
 	r.httpHandler.Post(path, r.WrapHTTPHandler(handler))
+	r.addRoute(path, http.MethodPost)
 }
 
 //
 // Put registers an HTTP PUT httpHandler.
 //
 func (r *Router) Put(path string, handler RequestHandler) {
-	r.httpHandler.Post(path, r.WrapHTTPHandler(handler))
+	r.httpHandler.Put(path, r.WrapHTTPHandler(handler))
+	r.addRoute(path, http.MethodPut)
+}
+
+//
+// Patch registers an HTTP PATCH httpHandler.
+//
+func (r *Router) Patch(path string, handler RequestHandler) {
+	r.httpHandler.Patch(path, r.WrapHTTPHandler(handler))
+	r.addRoute(path, http.MethodPatch)
 }
 
 //
 // Delete registers an HTTP DELETE httpHandler.
 //
 func (r *Router) Delete(path string, handler RequestHandler) {
-	r.httpHandler.Post(path, r.WrapHTTPHandler(handler))
+	r.httpHandler.Del(path, r.WrapHTTPHandler(handler))
+	r.addRoute(path, http.MethodDelete)
+}
+
+//
+// Head registers an HTTP HEAD httpHandler. It takes precedence over the GET handler of the path if registered first.
+//
+func (r *Router) Head(path string, handler RequestHandler) {
+	r.httpHandler.Head(path, r.WrapHTTPHandler(handler))
+	r.addRoute(path, http.MethodHead)
 }
 
 //
@@ -110,9 +161,7 @@ func (r *Router) WrapHTTPHandler(handler RequestHandler) http.HandlerFunc {
 
 	return func(response http.ResponseWriter, request *http.Request) {
 
-		var err error
-
-		//requestBody, err := r.requestReader.readBody(request)
+		requestBody, err := r.requestReader.readBody(request)
 		if err != nil {
 			r.log.Debug("%+v", err)
 			WriteResponseError(response, errors.ErrRequestRead)
@@ -120,6 +169,18 @@ func (r *Router) WrapHTTPHandler(handler RequestHandler) http.HandlerFunc {
 			return
 		}
 
+		if nil != r.container {
+			requestContainer, err := r.container.NewRequestContainer()
+			if nil != err {
+				r.log.Error("%+v", err)
+				WriteResponseError(response, errors.ErrInternalServerError)
+
+				return
+			}
+			defer requestContainer.Delete()
+			request = request.WithContext(context.WithValue(request.Context(), requestContainerKey{}, requestContainer))
+		}
+
 		if request, err = r.injectResource(handler, request, response); nil != err {
 			r.log.Debug("%s\n", err)
 			// ITODO: think on stack traces logging
@@ -140,17 +201,27 @@ func (r *Router) WrapHTTPHandler(handler RequestHandler) http.HandlerFunc {
 
 		// Handle the request and return an process an error if any.
 		handlerResponse := NewResponse()
-		//if err := handler.Handle(requestBody, handlerResponse, request); nil != err {
-		// TODO: log headers and request body
-		//r.handleError(response, err)
-		//return
-		//}
+		if err := handler.Handle(requestBody, handlerResponse, request); nil != err {
+			// TODO: log headers and request body
+			r.handleError(response, err)
+			return
+		}
 
 		response.WriteHeader(handlerResponse.GetStatus())
 		response.Write(handlerResponse.GetBody())
 	}
 }
 
+//
+// GetRequestContainer returns the request-scoped DI container created by the router.
+// It returns false if the router has no DI container set.
+//
+func GetRequestContainer(request *http.Request) (*cfg.DIContainer, bool) {
+	container, ok := request.Context().Value(requestContainerKey{}).(*cfg.DIContainer)
+
+	return container, ok
+}
+
 //
 // handleError handles the HTTP httpHandler error.
 //
@@ -188,6 +259,45 @@ func (r *Router) injectResource(
 	return request, nil
 }
 
+//
+// addRoute records the methods of the path pattern for the OPTIONS and HTTP 405 responses.
+//
+func (r *Router) addRoute(path string, methods ...string) {
+	for _, route := range r.routes {
+		if path == route.path {
+			route.addMethods(methods...)
+
+			return
+		}
+	}
+
+	route := newRoute(path)
+	route.addMethods(methods...)
+	r.routes = append(r.routes, route)
+}
+
+//
+// handleUnmatched handles the requests not matching any handler of the request method.
+// It answers OPTIONS and rejects other methods with HTTP 405 for the registered paths, all the other requests are not
+// found.
+//
+func (r *Router) handleUnmatched(response http.ResponseWriter, request *http.Request) {
+	allowed := getAllowedMethods(r.routes, request)
+	if 0 == len(allowed) {
+		defaultNotFoundHandler(response, request)
+
+		return
+	}
+
+	response.Header().Set("Allow", strings.Join(allowed, ", "))
+	if http.MethodOptions == request.Method {
+		response.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+	WriteResponseError(response, errors.ErrMethodNotAllowed)
+}
+
 //
 // defaultNotFoundHandler handles all HTTP Not Found errors.
 //
@@ -0,0 +1,227 @@
+package http
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sarulabs/di"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/cfg"
+	"github.com/ameteiko/golang-kit/errors"
+	"github.com/ameteiko/golang-kit/log"
+)
+
+type requestHandlerMock struct {
+	handle func(body []byte, response Responder, request *http.Request) error
+}
+
+func (h requestHandlerMock) Handle(body []byte, response Responder, request *http.Request) error {
+
+	return h.handle(body, response, request)
+}
+
+func TestRouter_WithADIContainer_ResolvesTheRequestDependencies(t *testing.T) {
+	container := cfg.NewDIContainer()
+	built, disposed := 0, 0
+	container.RegisterRequestDependency(
+		"session",
+		func(ctx di.Context) (interface{}, error) { built++; return built, nil },
+		func(obj interface{}) { disposed++ },
+	)
+	container.Build()
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	router.SetDIContainer(container)
+	var sessions []interface{}
+	router.Get("/sessions", requestHandlerMock{func(_ []byte, _ Responder, request *http.Request) error {
+		requestContainer, _ := GetRequestContainer(request)
+		sessions = append(sessions, requestContainer.Get("session"), requestContainer.Get("session"))
+
+		return nil
+	}})
+
+	for i := 0; i < 2; i++ {
+		router.GetHTTPHandler().ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/sessions", nil))
+	}
+
+	assert.Equal(t, []interface{}{1, 1, 2, 2}, sessions)
+	assert.Equal(t, 2, disposed)
+}
+
+func TestRouter_WithoutADIContainer_HasNoRequestContainer(t *testing.T) {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	ok := true
+	router.Get("/sessions", requestHandlerMock{func(_ []byte, _ Responder, request *http.Request) error {
+		_, ok = GetRequestContainer(request)
+
+		return nil
+	}})
+
+	router.GetHTTPHandler().ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/sessions", nil))
+
+	assert.False(t, ok)
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+
+	return 0, errors.New("read error")
+}
+
+func TestRouter_WithARequestBody_PassesItToTheHandler(t *testing.T) {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	var body []byte
+	router.Post("/users", requestHandlerMock{func(requestBody []byte, _ Responder, _ *http.Request) error {
+		body = requestBody
+
+		return nil
+	}})
+	recorder := httptest.NewRecorder()
+
+	router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("{}")))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []byte("{}"), body)
+}
+
+func TestRouter_WithAFailingRequestBody_ReturnsARequestReadErrorWithoutHandling(t *testing.T) {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	handled := false
+	router.Post("/users", requestHandlerMock{func(_ []byte, _ Responder, _ *http.Request) error {
+		handled = true
+
+		return nil
+	}})
+	recorder := httptest.NewRecorder()
+
+	router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/users", failingReader{}))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, errors.ErrRequestRead.Error(), recorder.Body.String())
+	assert.False(t, handled)
+}
+
+func TestRouter_WithAHandlerError_WritesTheErrorResponse(t *testing.T) {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	router.Get("/http-error", requestHandlerMock{func(_ []byte, _ Responder, _ *http.Request) error {
+
+		return errors.ErrRequestRead
+	}})
+	router.Get("/error", requestHandlerMock{func(_ []byte, _ Responder, _ *http.Request) error {
+
+		return errors.New("handler error")
+	}})
+	httpErrorRecorder, errorRecorder := httptest.NewRecorder(), httptest.NewRecorder()
+
+	router.GetHTTPHandler().ServeHTTP(httpErrorRecorder, httptest.NewRequest(http.MethodGet, "/http-error", nil))
+	router.GetHTTPHandler().ServeHTTP(errorRecorder, httptest.NewRequest(http.MethodGet, "/error", nil))
+
+	assert.Equal(t, http.StatusBadRequest, httpErrorRecorder.Code)
+	assert.Equal(t, http.StatusInternalServerError, errorRecorder.Code)
+	assert.Equal(t, errors.ErrInternalServerError.Error(), errorRecorder.Body.String())
+}
+
+func newMethodTestRouter() *Router {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	for method, register := range map[string]func(string, RequestHandler){
+		http.MethodPost:   router.Post,
+		http.MethodPut:    router.Put,
+		http.MethodPatch:  router.Patch,
+		http.MethodDelete: router.Delete,
+	} {
+		method := method
+		register("/users/:id", requestHandlerMock{func(_ []byte, response Responder, _ *http.Request) error {
+			response.SetBody(method)
+
+			return nil
+		}})
+	}
+
+	return router
+}
+
+func TestRouter_WithRegisteredMethods_DispatchesThem(t *testing.T) {
+	router := newMethodTestRouter()
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		recorder := httptest.NewRecorder()
+		router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(method, "/users/1", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, `"`+method+`"`, recorder.Body.String())
+	}
+}
+
+func TestRouter_WithAGetHandler_ServesHead(t *testing.T) {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	router.Get("/users", requestHandlerMock{func(_ []byte, _ Responder, _ *http.Request) error { return nil }})
+	recorder := httptest.NewRecorder()
+
+	router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodHead, "/users", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestRouter_WithAnOptionsRequest_ReturnsTheAllowedMethods(t *testing.T) {
+	router := newMethodTestRouter()
+	recorder := httptest.NewRecorder()
+
+	router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodOptions, "/users/1", nil))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "DELETE, OPTIONS, PATCH, POST, PUT", recorder.Header().Get("Allow"))
+}
+
+func TestRouter_WithANotAllowedMethod_ReturnsMethodNotAllowed(t *testing.T) {
+	router := newMethodTestRouter()
+	recorder := httptest.NewRecorder()
+	var response errors.HTTPError
+
+	router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	assert.Empty(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(t, "DELETE, OPTIONS, PATCH, POST, PUT", recorder.Header().Get("Allow"))
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, errors.ErrMethodNotAllowed.Code, response.Code)
+}
+
+func TestRouter_WithAnUnknownPath_ReturnsNotFound(t *testing.T) {
+	router := newMethodTestRouter()
+	recorder := httptest.NewRecorder()
+
+	router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodOptions, "/groups", nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("Allow"))
+}
+
+func TestRouter_WithParameterAndPrefixPatterns_ReturnsTheAllowedMethodsOfTheMatchingPaths(t *testing.T) {
+	router := NewRouter(log.New(ioutil.Discard, ""))
+	handler := requestHandlerMock{func(_ []byte, _ Responder, _ *http.Request) error { return nil }}
+	router.Get("/files/:name.json", handler)
+	router.Post("/static/", handler)
+	router.Put("/users/:id", handler)
+	router.Delete("/users/me", handler)
+	allowed := make(map[string]string)
+
+	for _, path := range []string{"/files/a.json", "/files/a.xml", "/static/css/a.css", "/users/me", "/users/"} {
+		recorder := httptest.NewRecorder()
+		router.GetHTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodOptions, path+"?q=1", nil))
+		allowed[path] = recorder.Header().Get("Allow")
+	}
+
+	assert.Equal(t, map[string]string{
+		"/files/a.json":     "GET, HEAD, OPTIONS",
+		"/files/a.xml":      "",
+		"/static/css/a.css": "OPTIONS, POST",
+		"/users/me":         "DELETE, OPTIONS, PUT",
+		"/users/":           "",
+	}, allowed)
+}
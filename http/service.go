@@ -11,15 +11,31 @@ import (
 	"github.com/ameteiko/golang-kit/log"
 )
 
+//
+// DefaultShutdownTimeout is a default deadline of the graceful shutdown.
+//
+const DefaultShutdownTimeout = 5 * time.Second
+
+//
+// Lifecycle starts the service dependencies before the service starts listening and stops them on the shutdown,
+// e.g. cfg.DIContainer.
+//
+type Lifecycle interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
 //
 // Service class.
 //
 type Service struct {
 	log              log.Logger
 	router           HandlerProvider
+	lifecycle        Lifecycle
 	httpAddress      string
 	httpReadTimeout  time.Duration
 	httpWriteTimeout time.Duration
+	shutdownTimeout  time.Duration
 }
 
 //
@@ -38,13 +54,42 @@ func NewService(
 		httpAddress:      httpAddress,
 		httpReadTimeout:  httpReadTimeout,
 		httpWriteTimeout: httpWriteTimeout,
+		shutdownTimeout:  DefaultShutdownTimeout,
 	}
 }
 
+//
+// SetLifecycle sets the lifecycle of the service dependencies.
+//
+func (s *Service) SetLifecycle(lifecycle Lifecycle) {
+	s.lifecycle = lifecycle
+}
+
+//
+// SetShutdownTimeout sets the global deadline of the graceful shutdown, it covers both the HTTP server shutdown and
+// stopping the dependencies.
+//
+func (s *Service) SetShutdownTimeout(timeout time.Duration) {
+	s.shutdownTimeout = timeout
+}
+
 //
 // Run performs starts all application logic.
+// The lifecycle dependencies are started before listening and stopped in the reverse dependency order after the HTTP
+// server shutdown on SIGINT or SIGTERM, or when the server fails to listen.
 //
 func (s *Service) Run() {
+	if nil != s.lifecycle {
+		if err := s.lifecycle.Start(context.Background()); nil != err {
+			s.log.Error(`%+v`, err)
+			ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+			defer cancel()
+			s.stopLifecycle(ctx)
+
+			return
+		}
+	}
+
 	s.log.Info(`Start listening address %v`, s.httpAddress)
 	srv := http.Server{
 		Addr:         s.httpAddress,
@@ -53,17 +98,25 @@ func (s *Service) Run() {
 		WriteTimeout: s.httpWriteTimeout,
 	}
 
-	ch := make(chan os.Signal)
-	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM, syscall.SIGKILL)
+	done, stop := make(chan struct{}), make(chan struct{})
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(ch)
 	go func() {
-		<-ch
-		s.log.Info(`Graceful shutdown...`)
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer close(done)
+		select {
+		case <-ch:
+			s.log.Info(`Graceful shutdown...`)
+		case <-stop:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
 		defer cancel()
 
 		if err := srv.Shutdown(ctx); err != nil {
 			s.log.Error(`%+v`, err)
 		}
+		s.stopLifecycle(ctx)
 
 		s.log.Info(`Service has been stopped`)
 	}()
@@ -71,5 +124,21 @@ func (s *Service) Run() {
 	err := srv.ListenAndServe()
 	if err != nil && err != http.ErrServerClosed {
 		s.log.Error("%+v\n", err)
+		close(stop)
+	}
+
+	<-done
+}
+
+//
+// stopLifecycle stops the lifecycle dependencies within the context deadline.
+//
+func (s *Service) stopLifecycle(ctx context.Context) {
+	if nil == s.lifecycle {
+		return
+	}
+
+	if err := s.lifecycle.Stop(ctx); nil != err {
+		s.log.Error(`%+v`, err)
 	}
 }
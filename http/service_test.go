@@ -0,0 +1,163 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ameteiko/golang-kit/errors"
+	"github.com/ameteiko/golang-kit/log"
+)
+
+type lifecycleMock struct {
+	startErr error
+	stopped  bool
+	deadline bool
+	ctxErr   error
+}
+
+type handlerMock struct {
+	handler http.HandlerFunc
+}
+
+func (h handlerMock) GetHTTPHandler() http.Handler {
+
+	return h.handler
+}
+
+func (l *lifecycleMock) Start(ctx context.Context) error {
+
+	return l.startErr
+}
+
+func (l *lifecycleMock) Stop(ctx context.Context) error {
+	l.stopped = true
+	_, l.deadline = ctx.Deadline()
+	l.ctxErr = ctx.Err()
+
+	return nil
+}
+
+func getFreeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
+
+func runService(service *Service) chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		service.Run()
+		close(done)
+	}()
+
+	return done
+}
+
+//
+// waitForShutdown sends SIGTERM to the process until the service stops. The test subscribes to the signal itself,
+// so the signal sent before the service subscription does not terminate the test process.
+//
+func waitForShutdown(t *testing.T, done chan struct{}) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGTERM)
+	defer signal.Stop(ch)
+	timeout := time.After(5 * time.Second)
+	for {
+		syscall.Kill(os.Getpid(), syscall.SIGTERM)
+		select {
+		case <-done:
+			return
+		case <-timeout:
+			t.Fatal("the service has not been stopped")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestService_WithASignal_ShutsDownTheServerAndStopsTheLifecycle(t *testing.T) {
+	lifecycle := &lifecycleMock{}
+	output := &bytes.Buffer{}
+	service := NewService(NewRouter(log.New(ioutil.Discard, "")), log.New(output, ""), getFreeAddress(t), 0, 0)
+	service.SetLifecycle(lifecycle)
+
+	waitForShutdown(t, runService(service))
+
+	assert.True(t, lifecycle.stopped)
+	assert.True(t, lifecycle.deadline)
+	assert.Empty(t, lifecycle.ctxErr)
+	assert.Contains(t, output.String(), "Service has been stopped")
+}
+
+func TestService_WithAnExpiredShutdownDeadline_StopsTheLifecycleWithTheExpiredContext(t *testing.T) {
+	lifecycle := &lifecycleMock{}
+	address, requested, release := getFreeAddress(t), make(chan struct{}), make(chan struct{})
+	defer close(release)
+	router := handlerMock{func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		<-release
+	}}
+	output := &bytes.Buffer{}
+	service := NewService(router, log.New(output, ""), address, 0, 0)
+	service.SetLifecycle(lifecycle)
+	service.SetShutdownTimeout(50 * time.Millisecond)
+
+	done := runService(service)
+	go func() {
+		for {
+			if _, err := http.Get("http://" + address); nil == err {
+				return
+			}
+			select {
+			case <-requested:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}()
+	<-requested
+	waitForShutdown(t, done)
+
+	assert.True(t, lifecycle.stopped)
+	assert.Equal(t, context.DeadlineExceeded, lifecycle.ctxErr)
+	assert.Contains(t, output.String(), context.DeadlineExceeded.Error())
+}
+
+func TestService_WithABusyAddress_StopsTheLifecycleAndReturns(t *testing.T) {
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	defer listener.Close()
+	lifecycle := &lifecycleMock{}
+	logger := log.New(ioutil.Discard, "")
+	service := NewService(NewRouter(logger), logger, listener.Addr().String(), 0, 0)
+	service.SetLifecycle(lifecycle)
+
+	service.Run()
+
+	assert.True(t, lifecycle.stopped)
+	assert.True(t, lifecycle.deadline)
+}
+
+func TestService_WithAFailingLifecycleStart_StopsTheLifecycleWithADeadline(t *testing.T) {
+	lifecycle := &lifecycleMock{startErr: errors.New("start error")}
+	service := NewService(NewRouter(log.New(ioutil.Discard, "")), log.New(ioutil.Discard, ""), ":0", 0, 0)
+	service.SetLifecycle(lifecycle)
+	service.SetShutdownTimeout(time.Second)
+
+	service.Run()
+
+	assert.True(t, lifecycle.stopped)
+	assert.True(t, lifecycle.deadline)
+}
@@ -15,6 +15,11 @@ const (
 	SeverityDebug = "DEBUG"
 )
 
+//
+// severityLevels orders the severities from the least to the most severe one.
+//
+var severityLevels = map[string]int{SeverityDebug: 0, SeverityInfo: 1, SeverityError: 2}
+
 //
 // Logger interface is the interface for the Log object.
 // ITODO: Think on two logging streams: regular and stacktrace one
@@ -36,11 +41,14 @@ type Logger interface {
 type Log struct {
 	writer   io.Writer
 	severity string
+	filtered bool
+	redactor func(message string) string
 	mu       sync.RWMutex
 }
 
 //
-// New returns an instance of a logger.
+// New returns an instance of a logger. All the messages are written regardless of the severity until the severity
+// filtering is enabled with SetSeverity.
 //
 func New(writer io.Writer, severity string) *Log {
 	return &Log{
@@ -49,6 +57,40 @@ func New(writer io.Writer, severity string) *Log {
 	}
 }
 
+//
+// SetSeverity sets the logging severity and enables the severity filtering. Messages less severe than the severity
+// are skipped, i.e. DEBUG messages are skipped for the INFO severity. An unknown severity enables all the messages.
+//
+func (l *Log) SetSeverity(severity string) {
+	l.mu.Lock()
+	{
+		l.severity = severity
+		l.filtered = true
+	}
+	l.mu.Unlock()
+}
+
+//
+// GetSeverity returns the logging severity.
+//
+func (l *Log) GetSeverity() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.severity
+}
+
+//
+// SetRedactor sets a function to mask the secrets in the log messages before they are written.
+//
+func (l *Log) SetRedactor(redactor func(message string) string) {
+	l.mu.Lock()
+	{
+		l.redactor = redactor
+	}
+	l.mu.Unlock()
+}
+
 //
 // Error logs an error.
 //
@@ -86,10 +128,23 @@ func (l *Log) Info(format string, args ...interface{}) {
 //
 func (l *Log) write(severity string, format string, args ...interface{}) {
 	l.mu.RLock()
-	{
+	if !l.filtered || !isSeveritySkipped(severity, l.severity) {
 		m := fmt.Sprintf(format, args...)
+		if nil != l.redactor {
+			m = l.redactor(m)
+		}
 		message := fmt.Sprintf("[%s] %s\n", severity, m)
 		l.writer.Write([]byte(message))
 	}
 	l.mu.RUnlock()
 }
+
+//
+// isSeveritySkipped returns true if the message severity is less than the logging severity.
+//
+func isSeveritySkipped(severity, logSeverity string) bool {
+	level, ok := severityLevels[severity]
+	logLevel, logOk := severityLevels[logSeverity]
+
+	return ok && logOk && level < logLevel
+}
@@ -0,0 +1,31 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_WithTheErrorSeverity_WritesAllTheMessages(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := New(buffer, SeverityError)
+
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Error("error")
+
+	assert.Equal(t, "[DEBUG] debug\n[INFO] info\n[ERROR] error\n", buffer.String())
+}
+
+func TestSetSeverity_WithTheInfoSeverity_SkipsTheDebugMessages(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := New(buffer, SeverityDebug)
+
+	logger.SetSeverity(SeverityInfo)
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Error("error")
+
+	assert.Equal(t, "[INFO] info\n[ERROR] error\n", buffer.String())
+}